@@ -0,0 +1,138 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/agenda"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// agendaCmd represents the agenda command
+var agendaCmd = &cobra.Command{
+	Use:   "agenda",
+	Short: "Show overdue, today's and upcoming due notes and tasks",
+	Long: `Show the notes and open tasks with a due date, grouped into overdue, due today and
+upcoming within the next --days days. Set due dates with 'simple-jot due' or 'create --due', or
+write them in a task as "- [ ] ship it due:2025-07-01".
+
+Examples:
+  simple-jot agenda
+  simple-jot agenda --days 14
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		days, _ := cmd.Flags().GetInt("days")
+		if days < 0 {
+			return fmt.Errorf("--days cannot be negative")
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		prefixLength := uniquePrefixLength(noteList)
+		a := agenda.Build(agenda.Collect(noteList), time.Now(), days)
+		if a.Empty() {
+			cmd.Printf("Nothing due in the next %d days.\n", days)
+			return nil
+		}
+
+		sections := []struct {
+			title string
+			items []agenda.Item
+		}{
+			{"Overdue", a.Overdue},
+			{"Today", a.Today},
+			{fmt.Sprintf("Upcoming (next %d days)", days), a.Upcoming},
+		}
+		for _, section := range sections {
+			if len(section.items) == 0 {
+				continue
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "\n%s\n", section.title)
+			if err := tabler.RenderTable(agendaRows(section.items, prefixLength), []string{"Due", "Item", "Note", "Ref"}); err != nil {
+				return fmt.Errorf("failed to render table: %w", err)
+			}
+		}
+		return nil
+	},
+}
+
+// remindCmd represents the remind command
+var remindCmd = &cobra.Command{
+	Use:   "remind",
+	Short: "List overdue items and items due today",
+	Long: `List the notes and open tasks that are overdue or due today. With --check the command exits
+with a non-zero status when anything is due, for use in shell prompts, scripts or cron.
+
+Examples:
+  simple-jot remind
+  simple-jot remind --check --quiet || echo 'something is due'
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		check, _ := cmd.Flags().GetBool("check")
+		quiet, _ := cmd.Flags().GetBool("quiet")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		prefixLength := uniquePrefixLength(noteList)
+		now := time.Now()
+		due := agenda.Build(agenda.Collect(noteList), now, 0).Due()
+
+		if !quiet {
+			if len(due) == 0 {
+				cmd.Println("Nothing due.")
+			}
+			for _, item := range due {
+				state := "due today"
+				if item.Due.Overdue(now) {
+					state = "overdue"
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%-9s  %-16s  %s (%s)\n", state, item.Due.String(), item.Text, agendaRef(item, prefixLength))
+			}
+		}
+
+		if check && len(due) > 0 {
+			// the exit status is the answer, so skip the usage text
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = quiet
+			return fmt.Errorf("%d item(s) due", len(due))
+		}
+		return nil
+	},
+}
+
+// agendaRows returns one table row per item.
+func agendaRows(items []agenda.Item, prefixLength int) [][]string {
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, []string{item.Due.String(), item.Text, item.NoteTitle, agendaRef(item, prefixLength)})
+	}
+	return rows
+}
+
+// agendaRef returns the task reference of a task item, or the short note ID of a note item.
+func agendaRef(item agenda.Item, prefixLength int) string {
+	if item.Task != nil {
+		return shortTaskRef(*item.Task, prefixLength)
+	}
+	return item.NoteID[:min(prefixLength, len(item.NoteID))]
+}
+
+func init() {
+	rootCmd.AddCommand(agendaCmd)
+	rootCmd.AddCommand(remindCmd)
+
+	agendaCmd.Flags().Int("days", 7, "Number of days ahead to show upcoming items for")
+	remindCmd.Flags().Bool("check", false, "Exit with a non-zero status when anything is due")
+	remindCmd.Flags().BoolP("quiet", "q", false, "Print nothing; only set the exit status")
+}
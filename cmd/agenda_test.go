@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestRemindCmdCheck(t *testing.T) {
+	yesterday := time.Now().AddDate(0, 0, -1).Format(time.DateOnly)
+	nextYear := time.Now().AddDate(1, 0, 0).Format(time.DateOnly)
+
+	tests := []struct {
+		name           string
+		notes          []notes.Note
+		expectedOutput string
+		expectedError  string
+	}{
+		{
+			name:           "Nothing due",
+			notes:          []notes.Note{{ID: "note1", Title: "Later", Due: nextYear, Content: "- [x] done due:" + yesterday}},
+			expectedOutput: "Nothing due.",
+		},
+		{
+			name:           "Overdue task",
+			notes:          []notes.Note{{ID: "note1", Title: "Release", Content: "- [ ] tag release due:" + yesterday}},
+			expectedOutput: "overdue",
+			expectedError:  "1 item(s) due",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage.SetDefaultStorage(&mockStorage{notes: tt.notes})
+
+			cmd := cobra.Command{Use: "remind", RunE: remindCmd.RunE}
+			cmd.Flags().Bool("check", false, "")
+			cmd.Flags().Bool("quiet", false, "")
+			output := new(bytes.Buffer)
+			cmd.SetOut(output)
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs([]string{"--check"})
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+			} else if err != nil {
+				t.Errorf("Did not expect an error but got: %v", err)
+			}
+			if !strings.Contains(output.String(), tt.expectedOutput) {
+				t.Errorf("Expected output to contain %q, got %q", tt.expectedOutput, output.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// askCmd represents the ask command
+var askCmd = &cobra.Command{
+	Use:   "ask <question>",
+	Short: "Ask a question and get an answer synthesized from your notes",
+	Long: `Ask a question about your notes. The most relevant notes are retrieved and sent to the
+configured LLM provider, which answers the question and cites the notes it used.
+
+Retrieval modes:
+  text      full-text ranking, works offline (default)
+  vector    the local vector index used by 'search --vector'
+  semantic  ranking by the LLM provider, as in 'search --semantic'
+
+Examples:
+  simple-jot ask 'what did we decide about the cache TTL?'
+  simple-jot ask 'who owns the billing migration?' --retrieval vector --limit 8 --show-sources
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		question := args[0]
+		retrieval, _ := cmd.Flags().GetString("retrieval")
+		limit, _ := cmd.Flags().GetInt("limit")
+		showSources, _ := cmd.Flags().GetBool("show-sources")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+
+		ranked, err := retrieveNotes(cmd, noteList, question, retrieval, limit)
+		if err != nil {
+			return err
+		}
+		if len(ranked) == 0 {
+			cmd.Println("No notes found that are relevant to the question.")
+			return nil
+		}
+
+		provider, err := newProvider()
+		if err != nil {
+			return err
+		}
+		answer, err := ai.Ask(cmd.Context(), provider, ai.DocumentsFromNotes(ranked), question, config.GetConfig().AIMaxTokens)
+		if err != nil {
+			return fmt.Errorf("failed to answer question: %w", err)
+		}
+
+		cmd.Println(answer.Answer)
+		if len(answer.Citations) == 0 {
+			return nil
+		}
+
+		noteStore := notes.NoteStore{}
+		noteStore.BuildNoteMap(ranked)
+		cmd.Println()
+		cmd.Println("Sources:")
+		for _, citation := range answer.Citations {
+			n, _ := noteStore.GetNoteByID(citation.PrimaryKey)
+			cmd.Printf("  [%s] %s\n", citation.PrimaryKey, n.Title)
+		}
+
+		if showSources {
+			headers := []string{"ID", "Title", "Excerpt"}
+			dataFrame := make([][]string, len(answer.Citations))
+			for i, citation := range answer.Citations {
+				n, _ := noteStore.GetNoteByID(citation.PrimaryKey)
+				dataFrame[i] = []string{citation.PrimaryKey, n.Title, citation.Excerpt}
+			}
+			if err := tabler.RenderTable(dataFrame, headers); err != nil {
+				return fmt.Errorf("failed to render table: %w", err)
+			}
+		}
+		return nil
+	},
+}
+
+// retrieveNotes returns up to limit notes relevant to query, most relevant first, using the given
+// retrieval mode: text, vector or semantic.
+func retrieveNotes(cmd *cobra.Command, noteList []notes.Note, query string, mode string, limit int) ([]notes.Note, error) {
+	var ranked []ai.SearchResponse
+	var err error
+	switch strings.ToLower(mode) {
+	case "", "text":
+		ranked = ai.RankByText(ai.DocumentsFromNotes(noteList), query, limit)
+	case "vector":
+		ranked, err = vectorSearch(noteList, noteList, query, limit)
+	case "semantic":
+		provider, providerErr := newProvider()
+		if providerErr != nil {
+			return nil, providerErr
+		}
+		opts := semanticSearchOptions(cmd)
+		opts.Limit = limit
+		ranked, err = ai.SemanticSearch(cmd.Context(), provider, ai.DocumentsFromNotes(noteList), query, opts)
+	default:
+		return nil, fmt.Errorf("unknown retrieval mode '%s'. Supported modes: text, vector, semantic", mode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve notes: %w", err)
+	}
+
+	noteStore := notes.NoteStore{}
+	noteStore.BuildNoteMap(noteList)
+	retrieved := make([]notes.Note, 0, len(ranked))
+	for _, result := range ranked {
+		if n, err := noteStore.GetNoteByID(result.PrimaryKey); err == nil {
+			retrieved = append(retrieved, n)
+		}
+	}
+	return retrieved, nil
+}
+
+func init() {
+	rootCmd.AddCommand(askCmd)
+
+	askCmd.Flags().StringP("retrieval", "r", "text", "How to find relevant notes: text, vector or semantic")
+	askCmd.Flags().IntP("limit", "l", 5, "Maximum number of notes to send to the LLM")
+	askCmd.Flags().Bool("show-sources", false, "Show the supporting excerpt from each cited note")
+}
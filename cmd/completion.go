@@ -0,0 +1,243 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/internal/tags"
+	"github.com/landanqrew/simple-jot/internal/tasks"
+	"github.com/spf13/cobra"
+)
+
+// completionCmd represents the completion command
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish|powershell>",
+	Short: "Generate the shell completion script",
+	Long: `Generate the completion script for your shell. Note arguments complete to short IDs with the
+note title as description, and --tag values complete to the tags already in use.
+
+Bash (requires the bash-completion package):
+  source <(simple-jot completion bash)
+  # or, to load it for every session:
+  simple-jot completion bash > /etc/bash_completion.d/simple-jot
+
+Zsh:
+  simple-jot completion zsh > "${fpath[1]}/_simple-jot"
+
+Fish:
+  simple-jot completion fish > ~/.config/fish/completions/simple-jot.fish
+
+PowerShell:
+  simple-jot completion powershell | Out-String | Invoke-Expression
+`,
+	Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	DisableFlagsInUseLine: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+		switch args[0] {
+		case "bash":
+			return cmd.Root().GenBashCompletionV2(out, true)
+		case "zsh":
+			return cmd.Root().GenZshCompletion(out)
+		case "fish":
+			return cmd.Root().GenFishCompletion(out, true)
+		case "powershell":
+			return cmd.Root().GenPowerShellCompletionWithDesc(out)
+		}
+		return fmt.Errorf("unsupported shell '%s'", args[0])
+	},
+}
+
+// minCompletionIDLength is the shortest ID prefix offered as a completion; longer prefixes are
+// used when needed to keep them unique.
+const minCompletionIDLength = 8
+
+// completeNoteArg completes the first argument of a command taking a note.
+func completeNoteArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeNotes(toComplete)
+}
+
+// completeNoteOrTagArgs completes a note followed by tags, as taken by 'tag add' and 'tag remove'.
+// The first argument may also be a tag for the active note.
+func completeNoteOrTagArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	tagCompletions, directive := completeTags(toComplete)
+	if len(args) > 0 {
+		return tagCompletions, directive
+	}
+	noteCompletions, _ := completeNotes(toComplete)
+	return append(noteCompletions, tagCompletions...), directive
+}
+
+// completeTagArgs completes every argument to a tag.
+func completeTagArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeTags(toComplete)
+}
+
+// completeTagFlag completes a --tag value, which may be a comma-separated list of tags.
+func completeTagFlag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	done, last := "", toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		done, last = toComplete[:i+1], toComplete[i+1:]
+	}
+	completions, directive := completeTags(last)
+	for i := range completions {
+		completions[i] = done + completions[i]
+	}
+	return completions, directive
+}
+
+// completeNotes returns the short IDs of the notes whose ID starts with toComplete, each described
+// by the note title, and @ for the active note.
+func completeNotes(toComplete string) ([]string, cobra.ShellCompDirective) {
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := make([]string, 0, len(noteList)+1)
+	if strings.HasPrefix(notes.ActiveNoteRef, toComplete) {
+		completions = append(completions, notes.ActiveNoteRef+"\tactive note")
+	}
+	prefixLength := max(uniquePrefixLength(noteList), len(toComplete))
+	for _, n := range noteList {
+		if !strings.HasPrefix(strings.ToLower(n.ID), strings.ToLower(toComplete)) {
+			continue
+		}
+		completions = append(completions, n.ID[:min(prefixLength, len(n.ID))]+"\t"+completionDescription(n.Title))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// uniquePrefixLength returns the shortest prefix length, at least minCompletionIDLength, that
+// tells every note ID apart.
+func uniquePrefixLength(noteList []notes.Note) int {
+	length := minCompletionIDLength
+	for {
+		seen := make(map[string]struct{}, len(noteList))
+		unique, exhausted := true, true
+		for _, n := range noteList {
+			prefix := strings.ToLower(n.ID[:min(length, len(n.ID))])
+			if _, ok := seen[prefix]; ok {
+				unique = false
+			}
+			seen[prefix] = struct{}{}
+			if len(n.ID) > length {
+				exhausted = false
+			}
+		}
+		if unique || exhausted {
+			return length
+		}
+		length++
+	}
+}
+
+// completionDescription keeps a note title on a single line for the shell.
+func completionDescription(title string) string {
+	return strings.Join(strings.Fields(title), " ")
+}
+
+// completeTags returns the tags in use that start with prefix, in their normalized form so that
+// aliases and differently cased copies of a tag are offered once.
+func completeTags(prefix string) ([]string, cobra.ShellCompDirective) {
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	normalizer := tagNormalizer()
+	if normalizer.FoldCase {
+		prefix = strings.ToLower(prefix)
+	}
+	tagMap := tags.TagMap{}
+	tagMap.BuildNormalizedTagMap(noteList, normalizer)
+	completions := tagMap.GetAllTags(prefix)
+	slices.Sort(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completePropArgs completes a note followed by property names, as taken by the prop commands.
+func completePropArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return completeNotes(toComplete)
+	}
+	if len(args) > 1 && cmd.Name() != "unset" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completePropertyKeys(toComplete)
+}
+
+// completePropertyFilter completes a --prop filter to a property name, or after "key=" to the
+// values in use for that property.
+func completePropertyFilter(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	key, _, hasValue := strings.Cut(toComplete, "=")
+	if !hasValue {
+		return completePropertyKeys(toComplete)
+	}
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, 0)
+	for _, n := range noteList {
+		if value, ok := n.GetProperty(key); ok && !slices.Contains(completions, key+"="+value) {
+			completions = append(completions, key+"="+value)
+		}
+	}
+	slices.Sort(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completePropertyKeys returns the property names in use that start with prefix.
+func completePropertyKeys(prefix string) ([]string, cobra.ShellCompDirective) {
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	keys := slices.DeleteFunc(notes.PropertyKeys(noteList), func(key string) bool {
+		return !strings.HasPrefix(key, prefix)
+	})
+	return keys, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTaskRefs completes the references of tasks, open ones unless --undo is given, with the
+// task text as description.
+func completeTaskRefs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveError
+	}
+	undo, _ := cmd.Flags().GetBool("undo")
+	prefixLength := uniquePrefixLength(noteList)
+	completions := make([]string, 0)
+	for _, task := range tasks.Extract(noteList) {
+		ref := shortTaskRef(task, prefixLength)
+		if task.Done == undo && strings.HasPrefix(ref, toComplete) && !slices.Contains(args, ref) {
+			completions = append(completions, ref+"\t"+completionDescription(task.Text))
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// registerTagFlagCompletion completes the --tag flag of cmd from the tags in use.
+func registerTagFlagCompletion(cmd *cobra.Command) {
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("tag", completeTagFlag))
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
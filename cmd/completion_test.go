@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestCompleteNotes(t *testing.T) {
+	storage.SetDefaultStorage(&mockStorage{notes: []notes.Note{
+		{ID: "3f2a9c41-aaaa", Title: "Q3 Planning"},
+		{ID: "3f2a9c42-bbbb", Title: "Design\nDoc"},
+		{ID: "7b1e0d55-cccc", Title: "Retro"},
+	}})
+
+	tests := []struct {
+		name       string
+		toComplete string
+		expected   []string
+	}{
+		{name: "All notes", toComplete: "", expected: []string{"@\tactive note", "3f2a9c41\tQ3 Planning", "3f2a9c42\tDesign Doc", "7b1e0d55\tRetro"}},
+		{name: "Prefix", toComplete: "3F2A", expected: []string{"3f2a9c41\tQ3 Planning", "3f2a9c42\tDesign Doc"}},
+		{name: "Longer than the short ID", toComplete: "7b1e0d55-c", expected: []string{"7b1e0d55-c\tRetro"}},
+		{name: "No match", toComplete: "zzz", expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, directive := completeNotes(tt.toComplete)
+			if directive != cobra.ShellCompDirectiveNoFileComp {
+				t.Errorf("Expected NoFileComp directive, got %v", directive)
+			}
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestUniquePrefixLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		ids      []string
+		expected int
+	}{
+		{name: "Distinct at the minimum", ids: []string{"aaaaaaaa1", "bbbbbbbb2"}, expected: minCompletionIDLength},
+		{name: "Shared prefix", ids: []string{"abcdefgh12", "abcdefgh13"}, expected: 10},
+		{name: "Duplicate IDs", ids: []string{"abc", "abc"}, expected: minCompletionIDLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			noteList := make([]notes.Note, 0, len(tt.ids))
+			for _, id := range tt.ids {
+				noteList = append(noteList, notes.Note{ID: id})
+			}
+			if got := uniquePrefixLength(noteList); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompleteTagFlag(t *testing.T) {
+	storage.SetDefaultStorage(&mockStorage{notes: []notes.Note{
+		{ID: "note1", Tags: []string{"work", "project/api"}},
+		{ID: "note2", Tags: []string{"personal", "project/web"}},
+	}})
+
+	tests := []struct {
+		toComplete string
+		expected   []string
+	}{
+		{toComplete: "", expected: []string{"personal", "project/api", "project/web", "work"}},
+		{toComplete: "pro", expected: []string{"project/api", "project/web"}},
+		{toComplete: "work,p", expected: []string{"work,personal", "work,project/api", "work,project/web"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.toComplete, func(t *testing.T) {
+			got, _ := completeTagFlag(&cobra.Command{}, nil, tt.toComplete)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompleteTagsNormalizes(t *testing.T) {
+	storage.SetDefaultStorage(&mockStorage{notes: []notes.Note{
+		{ID: "note1", Tags: []string{"Project/API", "k8s"}},
+		{ID: "note2", Tags: []string{"project/api", "kubernetes"}},
+	}})
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	viper.Set("tag_aliases", map[string]string{"k8s": "kubernetes"})
+	viper.Set("tag_case_fold", true)
+	t.Cleanup(func() {
+		viper.Set("tag_aliases", map[string]string{})
+		viper.Set("tag_case_fold", false)
+	})
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	tests := []struct {
+		toComplete string
+		expected   []string
+	}{
+		{toComplete: "", expected: []string{"kubernetes", "project/api"}},
+		{toComplete: "Pro", expected: []string{"project/api"}},
+		{toComplete: "k", expected: []string{"kubernetes"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.toComplete, func(t *testing.T) {
+			got, _ := completeTags(tt.toComplete)
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
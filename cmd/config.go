@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/storage"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -19,10 +20,17 @@ var configCmd = &cobra.Command{
 	Long: `Manage configuration settings for the application.
 
 Usage:
-  simple-jot config set note <note-id>
+  simple-jot config set note <note>
   simple-jot config get note
   simple-jot config set gemini-api-key <api-key>
   simple-jot config get gemini-api-key
+  simple-jot config set ai-provider <gemini|openai|ollama>
+  simple-jot config set ai-model <model>
+  simple-jot config set ai-base-url <url>
+  simple-jot config set ai-api-key <api-key>
+  simple-jot config set tag-case-fold <true|false>
+  simple-jot config set journal-title-format <layout>
+  simple-jot config set journal-carry-over <true|false>
 `,
 	// configCmd itself will not have a direct action, it acts as a container for subcommands.
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -57,29 +65,27 @@ var getCmd = &cobra.Command{
 
 // noteSetCmd represents the note subcommand of config set
 var noteSetCmd = &cobra.Command{
-	Use:   "note <note-id>",
+	Use:   "note <note>",
 	Short: "Set the current active note ID",
-	Long:  `Sets the specified note ID as the active note in the configuration.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Sets the specified note as the active note in the configuration. The note can be given
+by ID, unique ID prefix, title or slug.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		noteID := args[0]
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, args[0])
+		if err != nil {
+			return err
+		}
+		noteID := noteList[idx].ID
 
 		viper.Set("active_note", noteID)
 
-		// Try to write the config, and if it fails because no config file exists, create one
-		err := viper.WriteConfig()
-		if err != nil {
-			// If writing fails, try to write a new config file
-			home, homeErr := os.UserHomeDir()
-			if homeErr != nil {
-				return fmt.Errorf("failed to get home directory: %w", homeErr)
-			}
-
-			configPath := fmt.Sprintf("%s/.simple-jot.yaml", home)
-			err = viper.WriteConfigAs(configPath)
-			if err != nil {
-				return fmt.Errorf("error creating configuration file: %w", err)
-			}
+		if err := saveConfig(); err != nil {
+			return err
 		}
 
 		cmd.Printf("Active note set to: %s\n", noteID)
@@ -98,20 +104,8 @@ var geminiAPIKeySetCmd = &cobra.Command{
 
 		viper.Set("gemini_api_key", apiKey)
 
-		// Try to write the config, and if it fails because no config file exists, create one
-		err := viper.WriteConfig()
-		if err != nil {
-			// If writing fails, try to write a new config file
-			home, homeErr := os.UserHomeDir()
-			if homeErr != nil {
-				return fmt.Errorf("failed to get home directory: %w", homeErr)
-			}
-
-			configPath := fmt.Sprintf("%s/.simple-jot.yaml", home)
-			err = viper.WriteConfigAs(configPath)
-			if err != nil {
-				return fmt.Errorf("error creating configuration file: %w", err)
-			}
+		if err := saveConfig(); err != nil {
+			return err
 		}
 
 		cmd.Printf("Gemini API Key set successfully.\n")
@@ -153,6 +147,96 @@ var geminiAPIKeyGetCmd = &cobra.Command{
 	},
 }
 
+// configKey describes a plain string setting exposed through 'config set' and 'config get'.
+type configKey struct {
+	use  string // subcommand name, e.g. ai-provider
+	key  string // viper key, e.g. ai_provider
+	desc string // human readable name, e.g. AI provider
+	// values are offered as shell completions for 'config set', if the setting has a fixed set of values
+	values []string
+}
+
+// aiConfigKeys are the settings that select and configure the LLM provider.
+var aiConfigKeys = []configKey{
+	{use: "ai-provider", key: "ai_provider", desc: "AI provider (gemini, openai or ollama)", values: []string{"gemini", "openai", "ollama"}},
+	{use: "ai-model", key: "ai_model", desc: "AI model"},
+	{use: "ai-base-url", key: "ai_base_url", desc: "AI provider base URL"},
+	{use: "ai-api-key", key: "ai_api_key", desc: "AI provider API key"},
+}
+
+// editorConfigKey is the editor used by 'edit' and 'create --editor'. It defaults to $EDITOR.
+var editorConfigKey = configKey{use: "editor", key: "editor", desc: "editor"}
+
+// tagCaseFoldConfigKey makes tags that differ only in case the same tag when set to true.
+var tagCaseFoldConfigKey = configKey{use: "tag-case-fold", key: "tag_case_fold", desc: "tag case folding (true or false)", values: []string{"true", "false"}}
+
+// journalTitleFormatConfigKey is the Go time layout used for the titles of daily notes.
+var journalTitleFormatConfigKey = configKey{use: "journal-title-format", key: "journal_title_format", desc: "journal title format (Go time layout)"}
+
+// journalCarryOverConfigKey copies open tasks from the previous daily note when set to true.
+var journalCarryOverConfigKey = configKey{use: "journal-carry-over", key: "journal_carry_over", desc: "journal task carry-over (true or false)", values: []string{"true", "false"}}
+
+// newConfigSetCmd creates the 'config set' subcommand for k.
+func newConfigSetCmd(k configKey) *cobra.Command {
+	return &cobra.Command{
+		Use:   k.use + " <value>",
+		Short: "Set the " + k.desc,
+		Args:  cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return k.values, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			viper.Set(k.key, args[0])
+			if err := saveConfig(); err != nil {
+				return err
+			}
+			cmd.Printf("%s set to: %s\n", k.desc, args[0])
+			return nil
+		},
+	}
+}
+
+// newConfigGetCmd creates the 'config get' subcommand for k.
+func newConfigGetCmd(k configKey) *cobra.Command {
+	return &cobra.Command{
+		Use:   k.use,
+		Short: "Get the " + k.desc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			value := viper.GetString(k.key)
+			if value == "" {
+				cmd.Printf("No %s is currently set.\n", k.desc)
+			} else {
+				cmd.Println(value)
+			}
+			return nil
+		},
+	}
+}
+
+// saveConfig writes the current viper settings to the config file in use,
+// creating $HOME/.simple-jot.yaml if no config file exists yet.
+func saveConfig() error {
+	err := viper.WriteConfig()
+	if err != nil {
+		// If writing fails, try to write a new config file
+		home, homeErr := os.UserHomeDir()
+		if homeErr != nil {
+			return fmt.Errorf("failed to get home directory: %w", homeErr)
+		}
+
+		configPath := fmt.Sprintf("%s/.simple-jot.yaml", home)
+		err = viper.WriteConfigAs(configPath)
+		if err != nil {
+			return fmt.Errorf("error creating configuration file: %w", err)
+		}
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(configCmd)
 
@@ -163,6 +247,10 @@ func init() {
 	setCmd.AddCommand(geminiAPIKeySetCmd)
 	getCmd.AddCommand(noteGetCmd)
 	getCmd.AddCommand(geminiAPIKeyGetCmd)
+	for _, k := range append([]configKey{editorConfigKey, tagCaseFoldConfigKey, journalTitleFormatConfigKey, journalCarryOverConfigKey}, aiConfigKeys...) {
+		setCmd.AddCommand(newConfigSetCmd(k))
+		getCmd.AddCommand(newConfigGetCmd(k))
+	}
 
 	// No flags directly on configCmd anymore, they are on subcommands if needed.
 }
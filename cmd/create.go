@@ -5,12 +5,14 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/landanqrew/simple-jot/internal/notes"
 	"github.com/landanqrew/simple-jot/internal/osutils"
 	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
 	"github.com/landanqrew/simple-jot/tabler"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -27,6 +29,11 @@ Examples:
   simple-jot create my-first-note -n 'This is the content of my first note.'
   simple-jot create daily-log -n '2006-01-01 entry' -s
   cat some_file.txt | simple-jot create my-piped-note
+  simple-jot create standup -n 'blocked on review' --auto-tag --yes
+  simple-jot create meeting-notes --editor
+  simple-jot create retro -n 'went well: deploys' --tag team --tag retro
+  simple-jot create 'renew cert' -n 'expires soon' --due 'friday 9am'
+  simple-jot create 'API sync' --template meeting --var attendees='sam, kim'
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -35,12 +42,32 @@ Examples:
 		// Get flag values
 		noteContent, _ := cmd.Flags().GetString("note")
 		setNote, _ := cmd.Flags().GetBool("set")
+		useEditor, _ := cmd.Flags().GetBool("editor")
+		tagFlags, _ := cmd.Flags().GetStringSlice("tag")
+		autoTag, yes, err := autoTagFlags(cmd)
+		if err != nil {
+			return err
+		}
+		noteTags := normalizeTags(tagFlags)
+		var noteProperties map[string]string
+		noteDue := ""
+		if dueFlag, _ := cmd.Flags().GetString("due"); dueFlag != "" {
+			due, err := timeutils.ParseDue(dueFlag, time.Now())
+			if err != nil {
+				return err
+			}
+			noteDue = due.String()
+		}
 
 		// Check if content is provided via stdin
-		if stdinContent, err := osutils.ReadStdin(); err != nil {
+		stdinContent, err := osutils.ReadStdin()
+		if err != nil {
 			return fmt.Errorf("error reading from stdin: %v", err)
 		} else if stdinContent != "" {
 			// If content from stdin, prioritize it over flag content if both are present
+			if useEditor {
+				return fmt.Errorf("cannot use --editor with content piped to stdin")
+			}
 			if noteContent == "" {
 				noteContent = stdinContent
 			} else {
@@ -48,6 +75,49 @@ Examples:
 			}
 		}
 
+		noteSlice, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("failed to get notes: %v", err)
+		}
+
+		if templateName, _ := cmd.Flags().GetString("template"); templateName != "" {
+			vars, _ := cmd.Flags().GetStringArray("var")
+			rendered, err := renderTemplate(cmd, templateName, noteName, noteSlice, vars, stdinContent != "")
+			if err != nil {
+				return err
+			}
+			if rendered.Title != "" {
+				noteName = rendered.Title
+			}
+			// tags given on the command line come after the template's default tags
+			noteTags = normalizeTags(append(rendered.Tags, noteTags...))
+			noteProperties = rendered.Properties
+			if noteDue == "" {
+				noteDue = rendered.Due
+			}
+			// -n or stdin content goes below the template body
+			body := strings.TrimRight(rendered.Content, "\n")
+			if body != "" && noteContent != "" {
+				body += "\n\n"
+			}
+			noteContent = body + noteContent
+		}
+
+		if useEditor {
+			// -n pre-fills the body, which can then be edited
+			edited, err := editInEditor(notes.FrontMatter{Title: noteName, Tags: noteTags, Due: noteDue, Properties: noteProperties, Content: noteContent})
+			if err != nil {
+				return err
+			}
+			if edited.Title != "" {
+				noteName = edited.Title
+			}
+			noteTags = normalizeTags(edited.Tags)
+			noteDue = edited.Due
+			noteProperties = edited.Properties
+			noteContent = edited.Content
+		}
+
 		// Basic validation for note content
 		if noteContent == "" {
 			return fmt.Errorf("note content cannot be empty. Please use the -n or --note flag to provide content, or pipe content to stdin")
@@ -57,23 +127,25 @@ Examples:
 		cmd.Printf("Note content: %s\n", noteContent)
 		cmd.Printf("Set as current note: %t\n", setNote)
 
-		noteSlice, err := storage.GetNotes()
-		if err != nil {
-			return fmt.Errorf("failed to get notes: %v", err)
+		newNote := newNote(noteName, noteContent)
+		newNote.Properties = noteProperties
+		newNote.Due = noteDue
+		for _, tag := range noteTags {
+			newNote.AddTag(tag)
 		}
-		newNote := notes.Note{
-			ID:        uuid.New().String(),
-			Title:     noteName,
-			Content:   noteContent,
-			CreatedAt: time.Now().Format(time.DateTime),
-			UpdatedAt: time.Now().Format(time.DateTime),
-			Tags:      []string{},
+
+		if autoTag {
+			if _, err := autoTagNote(cmd, &newNote, noteSlice, yes); err != nil {
+				cmd.PrintErrf("Warning: %v\n", err)
+			}
 		}
+
 		noteSlice = append(noteSlice, newNote)
 		err = storage.SaveNotes(noteSlice)
 		if err != nil {
 			return fmt.Errorf("failed to save notes: %v", err)
 		}
+		updateVectorIndex(cmd, []notes.Note{newNote})
 
 		if setNote {
 			viper.Set("active_note", newNote.ID)
@@ -95,10 +167,31 @@ Examples:
 	},
 }
 
+// newNote builds a note with a fresh ID and creation timestamps.
+func newNote(title string, content string) notes.Note {
+	return notes.Note{
+		ID:        uuid.New().String(),
+		Title:     title,
+		Content:   content,
+		CreatedAt: time.Now().Format(time.DateTime),
+		UpdatedAt: time.Now().Format(time.DateTime),
+		Tags:      []string{},
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(createCmd)
 
 	// Define flags for the create command
 	createCmd.Flags().StringP("note", "n", "", "Content of the note. If not provided, content will be read from stdin.")
 	createCmd.Flags().BoolP("set", "s", false, "Set this note as the active configuration note")
+	createCmd.Flags().BoolP("editor", "e", false, "Write the note in the configured editor")
+	createCmd.Flags().StringSliceP("tag", "t", nil, "Tag the new note (repeatable or comma-separated)")
+	createCmd.Flags().String("due", "", "Due date, e.g. 2025-07-01, 'tomorrow 9am' or 'in 3 days'")
+	registerTagFlagCompletion(createCmd)
+	createCmd.Flags().String("template", "", "Start the note from a template in the templates directory")
+	cobra.CheckErr(createCmd.RegisterFlagCompletionFunc("template", completeTemplates))
+	createCmd.Flags().StringArray("var", nil, "Value for a prompt in the template, as name=value (repeatable)")
+	createCmd.Flags().Bool("auto-tag", false, "Suggest tags and a title for the note using the configured LLM")
+	createCmd.Flags().BoolP("yes", "y", false, "Apply --auto-tag suggestions without asking for confirmation")
 }
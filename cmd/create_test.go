@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -46,6 +47,14 @@ func TestCreateCmd(t *testing.T) {
 			expectedOutput: "cannot provide note content via both -n flag and stdin",
 			mockStorage:    &mockStorage{notes: []notes.Note{}},
 		},
+		{
+			name:           "Auto-tag with stdin and without --yes",
+			args:           []string{"Test Note", "--auto-tag"},
+			stdinContent:   "Stdin content",
+			expectedError:  true,
+			expectedOutput: "Pass --yes to apply the suggestions",
+			mockStorage:    &mockStorage{notes: []notes.Note{}},
+		},
 		{
 			name:           "Create note without content",
 			args:           []string{"Test Note"},
@@ -66,8 +75,9 @@ func TestCreateCmd(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Set up mock storage
+			// Set up mock storage and keep the vector index out of the real data directory
 			storage.SetDefaultStorage(tt.mockStorage)
+			t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
 
 			// Save original stdin and restore it after the test
 			oldStdin := os.Stdin
@@ -105,6 +115,8 @@ func TestCreateCmd(t *testing.T) {
 			// Add flags
 			cmd.Flags().StringP("note", "n", "", "Content of the note")
 			cmd.Flags().BoolP("set", "s", false, "Set as active note")
+			cmd.Flags().Bool("auto-tag", false, "Suggest tags and a title")
+			cmd.Flags().BoolP("yes", "y", false, "Apply suggestions without asking")
 
 			// Set up output capture
 			output := new(bytes.Buffer)
@@ -163,3 +175,34 @@ func TestCreateCmdValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCmdEditor(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test editor is a shell command")
+	}
+	mock := &mockStorage{notes: []notes.Note{}}
+	storage.SetDefaultStorage(mock)
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	t.Setenv("SIMPLE_JOT_EDITOR", `edit() { printf -- '---\ntitle: Written\n---\nWritten content\n\n' > "$1"; }; edit`)
+
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatalf("Failed to open /dev/null: %v", err)
+	}
+	defer devNull.Close()
+	oldStdin := os.Stdin
+	defer func() { os.Stdin = oldStdin }()
+	os.Stdin = devNull
+
+	cmd := cobra.Command{Use: "create", Args: createCmd.Args, RunE: createCmd.RunE}
+	cmd.Flags().StringP("note", "n", "", "Content of the note")
+	cmd.Flags().BoolP("editor", "e", false, "Write the note in the configured editor")
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"draft", "--editor"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Did not expect an error but got: %v", err)
+	}
+	if len(mock.notes) != 1 || mock.notes[0].Title != "Written" || mock.notes[0].Content != "Written content" {
+		t.Errorf("Expected a note titled Written with trimmed content, got %+v", mock.notes)
+	}
+}
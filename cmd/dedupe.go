@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/dedupe"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/osutils"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// dedupeCmd represents the dedupe command
+var dedupeCmd = &cobra.Command{
+	Use:   "dedupe",
+	Short: "Find and merge duplicate notes",
+	Long: `Find exact and near-duplicate notes by comparing MinHash signatures of their titles and
+content, and group them into clusters. Each cluster can be merged into its oldest note, which
+keeps its ID and creation time and gains the tags of every note in the cluster.
+
+Merge strategies for the content:
+  concat   join the distinct contents, oldest first
+  longest  keep the longest content
+  first    keep the content of the oldest note
+  latest   keep the content of the most recently updated note
+
+Without --strategy you are asked how to merge each cluster.
+
+Examples:
+  simple-jot dedupe --dry-run
+  simple-jot dedupe --threshold 0.7
+  simple-jot dedupe --strategy concat
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		strategy, _ := cmd.Flags().GetString("strategy")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if strategy != "" && !slices.Contains(dedupe.Strategies, strategy) {
+			return fmt.Errorf("unknown merge strategy '%s'. Supported strategies: %s", strategy, strings.Join(dedupe.Strategies, ", "))
+		}
+		if threshold <= 0 || threshold > 1 {
+			return fmt.Errorf("threshold must be between 0 and 1, got %v", threshold)
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+
+		clusters := dedupe.FindClusters(noteList, threshold)
+		if len(clusters) == 0 {
+			cmd.Println("No duplicate notes found.")
+			return nil
+		}
+
+		removed := make(map[string]struct{})
+		mergedNotes := make([]notes.Note, 0)
+		for i, cluster := range clusters {
+			kind := "near duplicates"
+			if cluster.Exact {
+				kind = "exact duplicates"
+			}
+			cmd.Printf("\nCluster %d of %d: %d %s (similarity %.0f%%)\n", i+1, len(clusters), len(cluster.Notes), kind, cluster.Similarity*100)
+			dataFrame := make([][]string, len(cluster.Notes))
+			for j, n := range cluster.Notes {
+				dataFrame[j] = n.PrepRow()
+			}
+			if err := tabler.RenderTable(dataFrame, cluster.Notes[0].GetHeaders()); err != nil {
+				return fmt.Errorf("failed to render table: %w", err)
+			}
+			if dryRun {
+				continue
+			}
+
+			clusterStrategy := strategy
+			if clusterStrategy == "" {
+				clusterStrategy, err = askMergeStrategy(cmd)
+				if err != nil {
+					return err
+				}
+				if clusterStrategy == "" {
+					cmd.Println("Skipped.")
+					continue
+				}
+			}
+
+			merged, err := dedupe.Merge(cluster.Notes, clusterStrategy)
+			if err != nil {
+				return err
+			}
+			for _, n := range cluster.Notes {
+				if n.ID != merged.ID {
+					removed[n.ID] = struct{}{}
+				}
+			}
+			mergedNotes = append(mergedNotes, merged)
+			cmd.Printf("Merged %d notes into %s.\n", len(cluster.Notes), merged.ID)
+		}
+
+		if len(mergedNotes) == 0 {
+			return nil
+		}
+
+		// apply every merge in a single save
+		replacements := make(map[string]notes.Note, len(mergedNotes))
+		for _, merged := range mergedNotes {
+			replacements[merged.ID] = merged
+		}
+		newNotes := make([]notes.Note, 0, len(noteList)-len(removed))
+		for _, n := range noteList {
+			if _, ok := removed[n.ID]; ok {
+				continue
+			}
+			if merged, ok := replacements[n.ID]; ok {
+				n = merged
+			}
+			newNotes = append(newNotes, n)
+		}
+		if err := storage.SaveNotes(newNotes); err != nil {
+			return fmt.Errorf("failed to save notes: %w", err)
+		}
+
+		removedIDs := make([]string, 0, len(removed))
+		for id := range removed {
+			removedIDs = append(removedIDs, id)
+		}
+		updateVectorIndex(cmd, mergedNotes, removedIDs...)
+		cmd.Printf("\nRemoved %d duplicate notes.\n", len(removed))
+		return nil
+	},
+}
+
+// askMergeStrategy prompts for the merge strategy of a cluster. An empty result means skip.
+func askMergeStrategy(cmd *cobra.Command) (string, error) {
+	for {
+		cmd.Print("Merge with [c]oncat, [l]ongest, [f]irst, la[t]est or [s]kip: ")
+		answer, err := osutils.ReadLine(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("cannot read answer: %w", err)
+		}
+		switch strings.ToLower(strings.TrimSpace(answer)) {
+		case "c", dedupe.StrategyConcat:
+			return dedupe.StrategyConcat, nil
+		case "l", dedupe.StrategyLongest:
+			return dedupe.StrategyLongest, nil
+		case "f", dedupe.StrategyFirst:
+			return dedupe.StrategyFirst, nil
+		case "t", dedupe.StrategyLatest:
+			return dedupe.StrategyLatest, nil
+		case "s", "skip", "":
+			return "", nil
+		}
+		cmd.Println("Please answer c, l, f, t or s.")
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(dedupeCmd)
+
+	dedupeCmd.Flags().Float64("threshold", dedupe.DefaultThreshold, "Minimum estimated similarity (0-1) for notes to count as near duplicates")
+	dedupeCmd.Flags().String("strategy", "", "Merge every cluster with this strategy: concat, longest, first or latest")
+	dedupeCmd.Flags().Bool("dry-run", false, "Only report duplicate clusters without merging them")
+}
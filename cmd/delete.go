@@ -5,58 +5,52 @@ package cmd
 
 import (
 	"fmt"
-	"log"
+	"slices"
 
-	"github.com/landanqrew/simple-jot/internal/notes"
 	"github.com/landanqrew/simple-jot/internal/storage"
 	"github.com/spf13/cobra"
 )
 
 // deleteCmd represents the delete command
 var deleteCmd = &cobra.Command{
-	Use:   "delete",
+	Use:   "delete <note>",
 	Short: "command to delete a note",
-	Long: `command that takes in a noteId and deletes the note from storage:
+	Long: `command that takes in a note and deletes it from storage. The note can be given by ID,
+unique ID prefix, title or slug, or '@' for the active note:
 
 examples:
   simple-jot delete 1234567890
+  simple-jot delete 3f2a
+  simple-jot delete "Meeting notes"
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) == 0 {
-			log.Fatal("note id is required")
-		}
-
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		// get notes
 		noteSlice, err := storage.GetNotes()
 		if err != nil {
-			log.Fatal("failed to get notes: " + err.Error())
+			return fmt.Errorf("failed to get notes: %w", err)
 		}
 
-		// find note based on id
-		noteId := args[0]
-		newNotes := make([]notes.Note,len(noteSlice))
-		found := false
-		for _, note := range noteSlice {
-			if note.ID != noteId {
-				newNotes = append(newNotes, note)
-			} else {
-				found = true
-			}
-		}
-		if !found {
-			log.Fatal("note not found")
+		// find note based on the reference
+		idx, err := resolveNote(noteSlice, args[0])
+		if err != nil {
+			return err
 		}
+		noteId := noteSlice[idx].ID
+		newNotes := slices.Delete(slices.Clone(noteSlice), idx, idx+1)
 
 		// save notes
 		err = storage.SaveNotes(newNotes)
 		if err != nil {
-			log.Fatal("failed to save notes: " + err.Error())
+			return fmt.Errorf("failed to save notes: %w", err)
 		}
-		fmt.Println("note deleted: " + noteId)
+		updateVectorIndex(cmd, nil, noteId)
+		cmd.Println("note deleted: " + noteId)
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(deleteCmd)
-	
 }
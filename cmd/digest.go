@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+	"github.com/spf13/cobra"
+)
+
+// digestTag marks notes created by 'digest --save'. Such notes are left out of later digests.
+const digestTag = "digest"
+
+// untaggedGroup is the digest section for notes without tags.
+const untaggedGroup = "untagged"
+
+// digestCmd represents the digest command
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Write a Markdown digest of recent notes grouped by tag",
+	Long: `Collect the notes created or updated within a period, group them by tag, summarize each
+group with the configured LLM provider and write the result as a Markdown report.
+
+Examples:
+  simple-jot digest --since 7d
+  simple-jot digest --since 2w --tag project-x --output digest.md
+  simple-jot digest --since 7d --save
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sinceStr, _ := cmd.Flags().GetString("since")
+		tagFilter, _ := cmd.Flags().GetString("tag")
+		output, _ := cmd.Flags().GetString("output")
+		save, _ := cmd.Flags().GetBool("save")
+
+		now := time.Now()
+		since, err := timeutils.ParseSince(sinceStr, now)
+		if err != nil {
+			return err
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+
+		groups := groupNotesByTag(notes.FilterNotesChangedSince(noteList, since), tagFilter)
+		if len(groups) == 0 {
+			cmd.Println("No notes found for the digest period.")
+			return nil
+		}
+
+		provider, err := newProvider()
+		if err != nil {
+			return err
+		}
+
+		title := fmt.Sprintf("Digest %s to %s", since.Format(time.DateOnly), now.Format(time.DateOnly))
+		var report strings.Builder
+		fmt.Fprintf(&report, "# %s\n", title)
+		for _, group := range groups {
+			cmd.PrintErrf("Summarizing %s (%d notes)...\n", group.tag, len(group.notes))
+			summary, err := ai.Summarize(cmd.Context(), provider, ai.DocumentsFromNotes(group.notes), "The notes share the tag '"+group.tag+"'.", config.GetConfig().AIMaxTokens)
+			if err != nil {
+				return fmt.Errorf("failed to summarize tag %s: %w", group.tag, err)
+			}
+
+			fmt.Fprintf(&report, "\n## %s (%d notes)\n\n%s\n\n### Notes\n\n", group.tag, len(group.notes), summary)
+			for _, n := range group.notes {
+				fmt.Fprintf(&report, "- %s (`%s`)\n", n.Title, n.ID)
+			}
+		}
+
+		if output != "" {
+			if err := os.WriteFile(output, []byte(report.String()), 0644); err != nil {
+				return fmt.Errorf("failed to write digest: %w", err)
+			}
+			cmd.Printf("Digest written to %s\n", output)
+		} else {
+			cmd.Print(report.String())
+		}
+
+		if save {
+			digestNote := newNote(title, report.String())
+			digestNote.AddTag(digestTag)
+			noteList = append(noteList, digestNote)
+			if err := storage.SaveNotes(noteList); err != nil {
+				return fmt.Errorf("failed to save notes: %w", err)
+			}
+			updateVectorIndex(cmd, []notes.Note{digestNote})
+			cmd.Printf("Digest saved as note %s\n", digestNote.ID)
+		}
+		return nil
+	},
+}
+
+// tagGroup is a digest section: the notes sharing one tag.
+type tagGroup struct {
+	tag   string
+	notes []notes.Note
+}
+
+// groupNotesByTag groups noteList by tag, sorted by tag name with untagged notes last. A note with
+// several tags appears in each of their groups. Previous digests are skipped, and when onlyTag is
+// set only that group is returned.
+func groupNotesByTag(noteList []notes.Note, onlyTag string) []tagGroup {
+	byTag := make(map[string][]notes.Note)
+	for _, n := range noteList {
+		if slices.Contains(n.Tags, digestTag) {
+			continue
+		}
+		if len(n.Tags) == 0 {
+			byTag[untaggedGroup] = append(byTag[untaggedGroup], n)
+			continue
+		}
+		for _, tag := range n.Tags {
+			byTag[tag] = append(byTag[tag], n)
+		}
+	}
+
+	groups := make([]tagGroup, 0, len(byTag))
+	for tag, tagged := range byTag {
+		if onlyTag != "" && tag != onlyTag {
+			continue
+		}
+		groups = append(groups, tagGroup{tag: tag, notes: tagged})
+	}
+	slices.SortFunc(groups, func(a, b tagGroup) int {
+		if (a.tag == untaggedGroup) != (b.tag == untaggedGroup) {
+			if a.tag == untaggedGroup {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a.tag, b.tag)
+	})
+	return groups
+}
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+
+	digestCmd.Flags().String("since", "7d", "Include notes changed within this period (e.g. 7d, 2w, 12h) or since a date (YYYY-MM-DD)")
+	digestCmd.Flags().StringP("tag", "t", "", "Only include notes with this tag")
+	registerTagFlagCompletion(digestCmd)
+	digestCmd.Flags().StringP("output", "o", "", "Write the Markdown report to this file instead of stdout")
+	digestCmd.Flags().Bool("save", false, "Also save the report as a new note tagged 'digest'")
+}
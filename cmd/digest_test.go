@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestGroupNotesByTag(t *testing.T) {
+	noteList := []notes.Note{
+		{ID: "1", Tags: []string{"work", "api"}},
+		{ID: "2", Tags: []string{"work"}},
+		{ID: "3", Tags: []string{}},
+		{ID: "4", Tags: []string{"digest", "work"}},
+	}
+
+	groups := groupNotesByTag(noteList, "")
+	expected := []struct {
+		tag   string
+		count int
+	}{{"api", 1}, {"work", 2}, {untaggedGroup, 1}}
+	if len(groups) != len(expected) {
+		t.Fatalf("Expected %d groups, got %d", len(expected), len(groups))
+	}
+	for i, e := range expected {
+		if groups[i].tag != e.tag || len(groups[i].notes) != e.count {
+			t.Errorf("Expected group %d to be %s with %d notes, got %s with %d", i, e.tag, e.count, groups[i].tag, len(groups[i].notes))
+		}
+	}
+
+	groups = groupNotesByTag(noteList, "api")
+	if len(groups) != 1 || groups[0].tag != "api" {
+		t.Errorf("Expected only the api group, got %v", groups)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+	"github.com/spf13/cobra"
+)
+
+// dueCmd represents the due command
+var dueCmd = &cobra.Command{
+	Use:   "due <note> [when]",
+	Short: "Set or show the due date of a note",
+	Long: `Set, show or clear the due date of a note. Dates can be ISO dates or natural language.
+Tasks inside a note take a due date in their text instead, e.g. "- [ ] ship it due:2025-07-01".
+
+Examples:
+  simple-jot due @ tomorrow
+  simple-jot due q3-planning 'friday 5pm'
+  simple-jot due q3-planning 2025-07-01
+  simple-jot due q3-planning 'in 2 weeks'
+  simple-jot due q3-planning             (show the due date)
+  simple-jot due q3-planning --clear
+`,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		clear, _ := cmd.Flags().GetBool("clear")
+		if clear && len(args) == 2 {
+			return fmt.Errorf("cannot use --clear with a due date")
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, args[0])
+		if err != nil {
+			return err
+		}
+		note := &noteList[idx]
+
+		if len(args) == 1 && !clear {
+			if note.Due == "" {
+				cmd.Printf("Note %s has no due date.\n", note.ID)
+			} else {
+				fmt.Fprintln(cmd.OutOrStdout(), note.Due)
+			}
+			return nil
+		}
+
+		note.Due = ""
+		if !clear {
+			due, err := timeutils.ParseDue(args[1], time.Now())
+			if err != nil {
+				return err
+			}
+			note.Due = due.String()
+		}
+		note.UpdatedAt = time.Now().Format(time.DateTime)
+
+		if err := storage.SaveNotes(noteList); err != nil {
+			return fmt.Errorf("cannot save notes: %w", err)
+		}
+		if clear {
+			cmd.Printf("Due date cleared on %s\n", note.ID)
+		} else {
+			cmd.Printf("Note %s is due %s\n", note.ID, note.Due)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dueCmd)
+
+	dueCmd.Flags().Bool("clear", false, "Remove the due date")
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/landanqrew/simple-jot/internal/config"
 	"github.com/landanqrew/simple-jot/internal/notes"
 	"github.com/landanqrew/simple-jot/internal/osutils"
 	"github.com/landanqrew/simple-jot/internal/storage"
@@ -16,24 +17,35 @@ import (
 
 // editCmd represents the edit command
 var editCmd = &cobra.Command{
-	Use:   "edit <note-id>",
+	Use:   "edit <note>",
 	Short: "edit a note",
 	Long: `edit the contents of a note:
 
 Usage:
+To open the note in your editor (config key "editor", defaults to $EDITOR):
+simple-jot edit <note>
+
 To overwrite the note:
-simple-jot edit <note-id> -n '<note-content>'
-cat <my-file.txt> | simple-jot edit <note-id>
+simple-jot edit <note> -n '<note-content>'
+cat <my-file.txt> | simple-jot edit <note>
 
 To append to the note:
-simple-jot edit <note-id> -a '<note-content>'`,
-	Args: cobra.ExactArgs(1),
+simple-jot edit <note> -a '<note-content>'
+
+To have the configured LLM suggest tags and a title after editing:
+simple-jot edit <note> -a '<note-content>' --auto-tag`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		noteID := args[0]
+		noteRef := args[0]
 
 		// Get flag values
 		noteContent, _ := cmd.Flags().GetString("note")
 		appendContent, _ := cmd.Flags().GetString("append")
+		autoTag, yes, err := autoTagFlags(cmd)
+		if err != nil {
+			return err
+		}
 
 		// check if stdin is from a pipe or redirect
 		stdinContent, err := osutils.ReadStdin()
@@ -52,8 +64,11 @@ simple-jot edit <note-id> -a '<note-content>'`,
 			noteContent = stdinContent
 		}
 
+		// without content flags the note is opened in the configured editor
+		useEditor := noteContent == "" && appendContent == "" && config.GetConfig().Editor != ""
+
 		// handle invalid args
-		if noteContent == "" && appendContent == "" {
+		if noteContent == "" && appendContent == "" && !useEditor {
 			return fmt.Errorf("note content cannot be empty. Please use the -n or --note flag to provide content, or pipe content to stdin")
 		} else if noteContent != "" && appendContent != "" {
 			return fmt.Errorf("cannot use both -n and -a flags. Please use only one")
@@ -65,26 +80,36 @@ simple-jot edit <note-id> -a '<note-content>'`,
 			return fmt.Errorf("cannot fetch notes: %v", err)
 		}
 
+		idx, err := resolveNote(noteList, noteRef)
+		if err != nil {
+			return err
+		}
+
 		// update note
-		found := false
-		currentNote := notes.Note{}
-		for i, n := range noteList {
-			if n.ID == noteID {
-				if appendContent != "" {
-					noteList[i].Content += appendContent
-				} else {
-					noteList[i].Content = noteContent
-				}
-				noteList[i].UpdatedAt = time.Now().Format(time.DateTime)
-				found = true
-				currentNote = noteList[i]
-				break
+		if useEditor {
+			changed, err := editNoteInEditor(&noteList[idx])
+			if err != nil {
+				return err
+			}
+			if !changed {
+				cmd.Println("No changes made.")
+				return nil
 			}
+		} else {
+			if appendContent != "" {
+				noteList[idx].Content += appendContent
+			} else {
+				noteList[idx].Content = noteContent
+			}
+			noteList[idx].UpdatedAt = time.Now().Format(time.DateTime)
 		}
 
-		if !found {
-			return fmt.Errorf("note with ID '%s' not found", noteID)
+		if autoTag {
+			if _, err := autoTagNote(cmd, &noteList[idx], noteList, yes); err != nil {
+				cmd.PrintErrf("Warning: %v\n", err)
+			}
 		}
+		currentNote := noteList[idx]
 
 		// save notes
 		err = storage.SaveNotes(noteList)
@@ -92,6 +117,7 @@ simple-jot edit <note-id> -a '<note-content>'`,
 			return fmt.Errorf("cannot save notes: %v", err)
 		}
 		cmd.Println("Note updated successfully.")
+		updateVectorIndex(cmd, []notes.Note{currentNote})
 
 		err = tabler.RenderTable([][]string{currentNote.PrepRow()}, currentNote.GetHeaders())
 		if err != nil {
@@ -107,4 +133,6 @@ func init() {
 
 	editCmd.Flags().StringP("note", "n", "", "Content of the note. If not provided, content will be read from stdin.")
 	editCmd.Flags().StringP("append", "a", "", "Append this content to the active configuration note")
+	editCmd.Flags().Bool("auto-tag", false, "Suggest tags and a title for the note using the configured LLM")
+	editCmd.Flags().BoolP("yes", "y", false, "Apply --auto-tag suggestions without asking for confirmation")
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -94,7 +95,15 @@ func TestEditCmd(t *testing.T) {
 			args:           []string{"non-existent-id", "-n", "New content"},
 			stdinContent:   "",
 			expectedError:  true,
-			expectedOutput: "note with ID 'non-existent-id' not found",
+			expectedOutput: "no note matches 'non-existent-id'",
+			mockStorage:    &mockStorage{notes: []notes.Note{existingNote}},
+		},
+		{
+			name:           "Auto-tag with stdin and without --yes",
+			args:           []string{"test-id", "--auto-tag"},
+			stdinContent:   "New content from stdin",
+			expectedError:  true,
+			expectedOutput: "Pass --yes to apply the suggestions",
 			mockStorage:    &mockStorage{notes: []notes.Note{existingNote}},
 		},
 		{
@@ -109,8 +118,11 @@ func TestEditCmd(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Set up mock storage
+			// Set up mock storage and keep the vector index out of the real data directory
 			storage.SetDefaultStorage(tt.mockStorage)
+			t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+			// without an editor, editing with no content flags is an error
+			t.Setenv("EDITOR", "")
 
 			// Save original stdin and restore it after the test
 			oldStdin := os.Stdin
@@ -148,6 +160,8 @@ func TestEditCmd(t *testing.T) {
 			// Add flags
 			cmd.Flags().StringP("note", "n", "", "Content of the note")
 			cmd.Flags().StringP("append", "a", "", "Append content to the note")
+			cmd.Flags().Bool("auto-tag", false, "Suggest tags and a title")
+			cmd.Flags().BoolP("yes", "y", false, "Apply suggestions without asking")
 
 			// Set up output capture
 			output := new(bytes.Buffer)
@@ -206,3 +220,77 @@ func TestEditCmdValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestEditCmdEditor(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test editor is a shell command")
+	}
+	existingNote := notes.Note{
+		ID:        "test-id",
+		Title:     "Test Note",
+		Content:   "Original content",
+		CreatedAt: time.Now().Format(time.DateTime),
+		UpdatedAt: time.Now().Format(time.DateTime),
+		Tags:      []string{"old"},
+	}
+
+	tests := []struct {
+		name           string
+		editor         string
+		mockStorage    *mockStorage
+		expectedOutput string
+		expectedNote   notes.Note
+	}{
+		{
+			name:           "Saves edited title, tags, due date and content",
+			editor:         `edit() { printf -- '---\ntitle: Renamed\ntags: [old, new]\ndue: 2025-07-01\n---\nEdited content\n' > "$1"; }; edit`,
+			mockStorage:    &mockStorage{notes: []notes.Note{existingNote}},
+			expectedOutput: "Note updated successfully",
+			expectedNote:   notes.Note{Title: "Renamed", Tags: []string{"old", "new"}, Due: "2025-07-01", Content: "Edited content"},
+		},
+		{
+			// saving would fail, so the test also checks that nothing is written
+			name:           "Skips the write when nothing changed",
+			editor:         "true",
+			mockStorage:    &mockStorage{notes: []notes.Note{existingNote}, saveError: fmt.Errorf("unexpected save")},
+			expectedOutput: "No changes made.",
+			expectedNote:   existingNote,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage.SetDefaultStorage(tt.mockStorage)
+			t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+			t.Setenv("SIMPLE_JOT_EDITOR", tt.editor)
+
+			devNull, err := os.Open(os.DevNull)
+			if err != nil {
+				t.Fatalf("Failed to open /dev/null: %v", err)
+			}
+			defer devNull.Close()
+			oldStdin := os.Stdin
+			defer func() { os.Stdin = oldStdin }()
+			os.Stdin = devNull
+
+			cmd := cobra.Command{Use: "edit", RunE: editCmd.RunE}
+			cmd.Flags().StringP("note", "n", "", "Content of the note")
+			cmd.Flags().StringP("append", "a", "", "Append content to the note")
+			output := new(bytes.Buffer)
+			cmd.SetOut(output)
+			cmd.SetErr(output)
+			cmd.SetArgs([]string{"test-id"})
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if !strings.Contains(output.String(), tt.expectedOutput) {
+				t.Errorf("Expected output to contain %q, got %q", tt.expectedOutput, output.String())
+			}
+			got := tt.mockStorage.notes[0]
+			if got.Title != tt.expectedNote.Title || got.Due != tt.expectedNote.Due || got.Content != tt.expectedNote.Content || strings.Join(got.Tags, ",") != strings.Join(tt.expectedNote.Tags, ",") {
+				t.Errorf("Expected note %+v, got %+v", tt.expectedNote, got)
+			}
+		})
+	}
+}
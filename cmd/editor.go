@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/osutils"
+)
+
+// editInEditor opens fm in the configured editor and parses the saved file back. Trailing
+// newlines, which editors commonly add on save, are dropped from the content, so notes end the
+// same way whether they were created or edited in the editor.
+func editInEditor(fm notes.FrontMatter) (notes.FrontMatter, error) {
+	editor := config.GetConfig().Editor
+	if editor == "" {
+		return fm, fmt.Errorf("no editor configured. Please set one using 'simple-jot config set editor <editor>' or the EDITOR environment variable")
+	}
+	text, err := osutils.EditText(editor, notes.FormatFrontMatter(fm), "simple-jot-*.md")
+	if err != nil {
+		return fm, err
+	}
+	edited, err := notes.ParseFrontMatter(text)
+	if err != nil {
+		return fm, fmt.Errorf("cannot parse edited note: %w", err)
+	}
+	edited.Content = strings.TrimRight(edited.Content, "\n")
+	return edited, nil
+}
+
+// editNoteInEditor opens note in the configured editor and applies the edited title, tags, due
+// date, properties and content to it. It reports whether anything changed. An emptied title keeps the old one.
+func editNoteInEditor(note *notes.Note) (bool, error) {
+	edited, err := editInEditor(notes.FrontMatter{Title: note.Title, Tags: note.Tags, Due: note.Due, Properties: note.Properties, Content: note.Content})
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	if edited.Title != "" && edited.Title != note.Title {
+		note.Title = edited.Title
+		changed = true
+	}
+	if !slices.Equal(edited.Tags, note.Tags) && (len(edited.Tags) > 0 || len(note.Tags) > 0) {
+		note.Tags = edited.Tags
+		changed = true
+	}
+	if edited.Due != note.Due {
+		note.Due = edited.Due
+		changed = true
+	}
+	if !maps.Equal(edited.Properties, note.Properties) {
+		note.Properties = edited.Properties
+		changed = true
+	}
+	// a final newline on the stored content is not a change worth saving
+	if edited.Content != strings.TrimRight(note.Content, "\n") {
+		note.Content = edited.Content
+		changed = true
+	}
+	if changed {
+		note.UpdatedAt = time.Now().Format(time.DateTime)
+	}
+	return changed, nil
+}
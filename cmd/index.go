@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// indexCmd represents the index command
+var indexCmd = &cobra.Command{
+	Use:   "index",
+	Short: "Manage the local vector index used by 'search --vector'",
+	Long: `Manage the local vector index used by 'search --vector'.
+
+Notes are embedded when they are created or edited. Use rebuild to embed notes
+written before the index existed or after changing the embedder setting.
+
+Usage:
+  simple-jot index rebuild`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+// indexRebuildCmd represents the rebuild subcommand of index
+var indexRebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Re-embed every note into a fresh vector index",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		embedder, err := newEmbedder()
+		if err != nil {
+			return err
+		}
+
+		index := &ai.VectorIndex{Embedder: embedder.Name(), Entries: make(map[string]ai.IndexEntry)}
+		if err := index.Upsert(embedder, noteList...); err != nil {
+			return err
+		}
+		if err := index.Save(vectorIndexPath()); err != nil {
+			return err
+		}
+
+		cmd.Printf("Indexed %d notes with embedder %s.\n", len(index.Entries), embedder.Name())
+		return nil
+	},
+}
+
+// newEmbedder returns the embedder selected by the embedder config key.
+func newEmbedder() (ai.Embedder, error) {
+	name := config.GetConfig().Embedder
+	switch name {
+	case "", "hash":
+		return ai.NewHashEmbedder(ai.DefaultHashDimensions), nil
+	default:
+		return nil, fmt.Errorf("unknown embedder '%s'. Supported embedders: hash", name)
+	}
+}
+
+// vectorIndexPath is where the vector index is stored inside the data directory.
+func vectorIndexPath() string {
+	return filepath.Join(config.GetConfig().DataDir, "embeddings.json")
+}
+
+// loadVectorIndex opens the vector index for the configured embedder.
+func loadVectorIndex() (*ai.VectorIndex, ai.Embedder, error) {
+	embedder, err := newEmbedder()
+	if err != nil {
+		return nil, nil, err
+	}
+	index, err := ai.LoadVectorIndex(vectorIndexPath(), embedder.Name())
+	if err != nil {
+		return nil, nil, err
+	}
+	return index, embedder, nil
+}
+
+// updateVectorIndex embeds the given notes and drops the removed IDs. The notes themselves are
+// already saved at this point, so failures are reported as warnings rather than errors.
+func updateVectorIndex(cmd *cobra.Command, changed []notes.Note, removedIDs ...string) {
+	index, embedder, err := loadVectorIndex()
+	if err == nil {
+		for _, id := range removedIDs {
+			index.Remove(id)
+		}
+		err = index.Upsert(embedder, changed...)
+	}
+	if err == nil {
+		err = index.Save(vectorIndexPath())
+	}
+	if err != nil {
+		cmd.PrintErrf("Warning: failed to update vector index: %v\n", err)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(indexCmd)
+	indexCmd.AddCommand(indexRebuildCmd)
+}
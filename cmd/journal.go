@@ -0,0 +1,186 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/journal"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/osutils"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// todayCmd represents the today command
+var todayCmd = &cobra.Command{
+	Use:   "today [text]",
+	Short: "Add a timestamped entry to today's journal note",
+	Long: `Find or create the daily note for today and append a timestamped entry to it. Daily notes
+are tagged 'journal' and titled with the date, formatted with the journal-title-format setting
+(a Go time layout, "2006-01-02" by default). Without text the note is printed.
+
+When today's note is created, open tasks from the previous daily note are moved into it if
+--carry-over is given or journal-carry-over is set to true: they are copied to today's note and
+marked "- [>] ... (carried to <date>)" in the previous one, which is neither open nor done.
+
+Examples:
+  simple-jot today 'standup: reviewing the auth PR'
+  echo 'deployed v2' | simple-jot today
+  simple-jot today --carry-over
+  simple-jot today
+  simple-jot config set journal-title-format 'Monday 2 January 2006'
+`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		text := ""
+		if len(args) == 1 {
+			text = args[0]
+		} else if stdinContent, err := osutils.ReadStdin(); err != nil {
+			return fmt.Errorf("error reading from stdin: %v", err)
+		} else {
+			text = stdinContent
+		}
+		carryOver, _ := cmd.Flags().GetBool("carry-over")
+		carryOver = carryOver || config.GetConfig().JournalCarryOver
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		j := journalFromConfig()
+		now := time.Now()
+
+		idx := j.Find(noteList, now)
+		created := idx == -1
+		// notes other than today's that need saving, i.e. a note tasks were carried over from
+		changed := []notes.Note{}
+		if created {
+			note := newNote(j.Title(now), "")
+			note.AddTag(journal.Tag)
+			if carryOver {
+				if prev := j.Previous(noteList, now); prev != -1 {
+					previous := &noteList[prev]
+					if open, updated := journal.CarryOver(previous.Content, note.Title); len(open) > 0 {
+						note.Content = fmt.Sprintf("## Carried over from %s\n%s\n", previous.Title, strings.Join(open, "\n"))
+						previous.Content = updated
+						previous.UpdatedAt = now.Format(time.DateTime)
+						changed = append(changed, *previous)
+						cmd.Printf("Carried over %d open task(s) from %s.\n", len(open), previous.Title)
+					}
+				}
+			}
+			noteList = append(noteList, note)
+			idx = len(noteList) - 1
+			cmd.Printf("Created daily note %s (%s).\n", note.Title, note.ID)
+		}
+		note := &noteList[idx]
+
+		if strings.TrimSpace(text) == "" {
+			if created {
+				if err := saveJournalNotes(cmd, noteList, append(changed, *note)); err != nil {
+					return err
+				}
+			}
+			fmt.Fprint(cmd.OutOrStdout(), formatNoteRaw(*note))
+			return nil
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+			if strings.TrimSpace(line) != "" {
+				note.Content = journal.Append(note.Content, journal.Entry(now, line))
+			}
+		}
+		note.UpdatedAt = now.Format(time.DateTime)
+		if err := saveJournalNotes(cmd, noteList, append(changed, *note)); err != nil {
+			return err
+		}
+		cmd.Printf("Added to %s.\n", note.Title)
+		return nil
+	},
+}
+
+// journalCmd represents the journal command
+var journalCmd = &cobra.Command{
+	Use:   "journal",
+	Short: "Show daily journal notes",
+	Long: `Show the daily note for today, for the day given with --date, or every daily note of the
+week (Monday to Sunday) containing that day with --week, oldest first.
+
+Examples:
+  simple-jot journal
+  simple-jot journal --date 2025-07-01
+  simple-jot journal --week
+  simple-jot journal --week --date 2025-07-01
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		week, _ := cmd.Flags().GetBool("week")
+		day := time.Now()
+		if dateFlag, _ := cmd.Flags().GetString("date"); dateFlag != "" {
+			parsed, err := time.ParseInLocation(time.DateOnly, dateFlag, time.Local)
+			if err != nil {
+				return fmt.Errorf("invalid --date '%s', expected YYYY-MM-DD", dateFlag)
+			}
+			day = parsed
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		j := journalFromConfig()
+
+		start, end := day, day
+		if week {
+			start, end = journal.Week(day)
+		}
+		dailyNotes := j.Between(noteList, start, end)
+		if len(dailyNotes) == 0 {
+			if week {
+				cmd.Printf("No daily notes in the week of %s.\n", start.Format(time.DateOnly))
+			} else {
+				cmd.Printf("No daily note for %s.\n", day.Format(time.DateOnly))
+			}
+			return nil
+		}
+
+		parts := make([]string, 0, len(dailyNotes))
+		for _, n := range dailyNotes {
+			parts = append(parts, formatNoteRaw(n))
+		}
+		fmt.Fprint(cmd.OutOrStdout(), strings.Join(parts, "\n"))
+		return nil
+	},
+}
+
+// journalFromConfig returns the journal with the configured title format.
+func journalFromConfig() journal.Journal {
+	format := config.GetConfig().JournalTitleFormat
+	if format == "" {
+		format = journal.DefaultTitleFormat
+	}
+	return journal.Journal{TitleFormat: format}
+}
+
+// saveJournalNotes saves noteList and indexes the changed daily notes.
+func saveJournalNotes(cmd *cobra.Command, noteList []notes.Note, changed []notes.Note) error {
+	if err := storage.SaveNotes(noteList); err != nil {
+		return fmt.Errorf("cannot save notes: %w", err)
+	}
+	updateVectorIndex(cmd, changed)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(todayCmd)
+	rootCmd.AddCommand(journalCmd)
+
+	todayCmd.Flags().Bool("carry-over", false, "Move open tasks from the previous daily note when creating today's note")
+	journalCmd.Flags().String("date", "", "Day to show, as YYYY-MM-DD (default today)")
+	journalCmd.Flags().Bool("week", false, "Show every daily note of the week")
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/journal"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestTodayCmd(t *testing.T) {
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	viper.Set("journal_carry_over", true)
+	t.Cleanup(func() { viper.Set("journal_carry_over", false) })
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatalf("Failed to open /dev/null: %v", err)
+	}
+	defer devNull.Close()
+	oldStdin := os.Stdin
+	os.Stdin = devNull
+	defer func() { os.Stdin = oldStdin }()
+
+	yesterday := time.Now().AddDate(0, 0, -1).Format(journal.DefaultTitleFormat)
+	mock := &mockStorage{notes: []notes.Note{
+		{ID: "note1", Title: yesterday, Tags: []string{journal.Tag}, Content: "- [ ] follow up due:" + yesterday + "\n- [x] done"},
+	}}
+	storage.SetDefaultStorage(mock)
+
+	run := func(args ...string) string {
+		cmd := cobra.Command{Use: todayCmd.Use, Args: todayCmd.Args, RunE: todayCmd.RunE}
+		cmd.Flags().Bool("carry-over", false, "")
+		output := new(bytes.Buffer)
+		cmd.SetOut(output)
+		cmd.SetErr(output)
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("today returned an error: %v", err)
+		}
+		return output.String()
+	}
+
+	output := run("first entry")
+	if !strings.Contains(output, "Carried over 1 open task(s)") {
+		t.Errorf("Expected the open task to be carried over, got %q", output)
+	}
+	run("second entry")
+	if len(mock.notes) != 2 {
+		t.Fatalf("Expected one new daily note, got %d notes", len(mock.notes))
+	}
+
+	today := mock.notes[1]
+	if today.Title != time.Now().Format(journal.DefaultTitleFormat) || today.Tags[0] != journal.Tag {
+		t.Errorf("Expected a journal note titled with today's date, got %+v", today)
+	}
+	lines := strings.Split(today.Content, "\n")
+	if len(lines) != 4 || lines[1] != "- [ ] follow up due:"+yesterday || !strings.HasSuffix(lines[2], " first entry") ||
+		!strings.HasSuffix(lines[3], " second entry") {
+		t.Errorf("Unexpected daily note content %q", today.Content)
+	}
+
+	if output := run(); !strings.Contains(output, "second entry") {
+		t.Errorf("Expected today's note to be printed, got %q", output)
+	}
+	if expected := "- [>] follow up due:" + yesterday + " (carried to " + today.Title + ")\n- [x] done"; mock.notes[0].Content != expected {
+		t.Errorf("Expected the carried task to be marked as carried in the previous note, got %q", mock.notes[0].Content)
+	}
+
+	// the carried task is open in today's note only, so it is due once
+	remind := cobra.Command{Use: "remind", RunE: remindCmd.RunE}
+	remind.Flags().Bool("check", false, "")
+	remind.Flags().Bool("quiet", false, "")
+	remind.SetOut(new(bytes.Buffer))
+	remind.SetErr(new(bytes.Buffer))
+	remind.SetArgs([]string{"--check"})
+	if err := remind.Execute(); err == nil || err.Error() != "1 item(s) due" {
+		t.Errorf("Expected remind to report 1 item due, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/landanqrew/simple-jot/internal/links"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// linksCmd represents the links command
+var linksCmd = &cobra.Command{
+	Use:   "links [note]",
+	Short: "Show the notes a note links to",
+	Long: `Show the [[wiki links]] in a note and the notes they resolve to. A link can name a note
+by title, slugified title, ID or unique ID prefix, e.g. [[Design Doc]], [[design-doc]] or
+[[3f2a]], optionally followed by a label: [[3f2a|the ADR]].
+
+With --broken, report the links that do not resolve to exactly one note, across all notes
+or only in the given note.
+
+Examples:
+  simple-jot links <note>
+  simple-jot links --broken
+`,
+	Args:              cobra.RangeArgs(0, 1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		broken, _ := cmd.Flags().GetBool("broken")
+		if len(args) == 0 && !broken {
+			return fmt.Errorf("please provide a note, or use --broken to check every note")
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		index := links.BuildIndex(noteList)
+		titles := make(map[string]string, len(noteList))
+		for _, n := range noteList {
+			titles[n.ID] = n.Title
+		}
+
+		var selected []links.Link
+		if len(args) == 1 {
+			idx, err := resolveNote(noteList, args[0])
+			if err != nil {
+				return err
+			}
+			selected = index.Outgoing[noteList[idx].ID]
+			if broken {
+				selected = filterBrokenLinks(selected)
+			}
+		} else {
+			selected = index.Broken()
+		}
+
+		if len(selected) == 0 {
+			if broken {
+				cmd.Println("No broken links found.")
+			} else {
+				cmd.Println("No links found.")
+			}
+			return nil
+		}
+
+		dataFrame := make([][]string, len(selected))
+		for i, link := range selected {
+			if link.Broken() {
+				dataFrame[i] = []string{titles[link.Source], link.Ref, "", "broken: " + link.Reason}
+			} else {
+				dataFrame[i] = []string{titles[link.Source], link.Ref, link.Target, titles[link.Target]}
+			}
+		}
+		if err := tabler.RenderTable(dataFrame, []string{"From", "Link", "ID", "Title"}); err != nil {
+			return fmt.Errorf("failed to render table: %w", err)
+		}
+		return nil
+	},
+}
+
+// backlinksCmd represents the backlinks command
+var backlinksCmd = &cobra.Command{
+	Use:   "backlinks <note>",
+	Short: "Show the notes that link to a note",
+	Long: `Show every note containing a [[wiki link]] that resolves to the given note.
+
+Examples:
+  simple-jot backlinks <note>
+  simple-jot backlinks "Design Doc"
+`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, args[0])
+		if err != nil {
+			return err
+		}
+
+		incoming := links.BuildIndex(noteList).Incoming[noteList[idx].ID]
+		if len(incoming) == 0 {
+			cmd.Println("No backlinks found.")
+			return nil
+		}
+
+		noteStore := notes.NoteStore{}
+		noteStore.BuildNoteMap(noteList)
+		dataFrame := make([][]string, 0, len(incoming))
+		seen := make(map[string]struct{})
+		for _, link := range incoming {
+			if _, ok := seen[link.Source]; ok {
+				continue
+			}
+			seen[link.Source] = struct{}{}
+			source, err := noteStore.GetNoteByID(link.Source)
+			if err != nil {
+				continue
+			}
+			dataFrame = append(dataFrame, []string{source.ID, source.Title, link.Ref})
+		}
+		if err := tabler.RenderTable(dataFrame, []string{"ID", "Title", "Link"}); err != nil {
+			return fmt.Errorf("failed to render table: %w", err)
+		}
+		return nil
+	},
+}
+
+// graphCmd represents the graph command
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Export the note graph",
+	Long: `Export the graph of notes and the [[wiki links]] between them, as Graphviz DOT or JSON.
+Broken links are left out; list them with 'simple-jot links --broken'.
+
+Examples:
+  simple-jot graph --format dot | dot -Tsvg -o notes.svg
+  simple-jot graph --format json --output graph.json
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		outputPath, _ := cmd.Flags().GetString("output")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		graph := links.BuildGraph(noteList, links.BuildIndex(noteList))
+
+		var output string
+		switch format {
+		case "dot":
+			output = graph.DOT()
+		case "json":
+			data, err := json.MarshalIndent(graph, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal graph: %w", err)
+			}
+			output = string(data) + "\n"
+		default:
+			return fmt.Errorf("unknown graph format '%s'. Supported formats: dot, json", format)
+		}
+
+		if outputPath == "" {
+			// write to stdout so the graph can be piped into other tools
+			fmt.Fprint(cmd.OutOrStdout(), output)
+			return nil
+		}
+		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
+			return fmt.Errorf("failed to write graph: %w", err)
+		}
+		cmd.Printf("Graph with %d notes and %d links written to %s\n", len(graph.Nodes), len(graph.Edges), outputPath)
+		return nil
+	},
+}
+
+// filterBrokenLinks returns the links in linkList that do not resolve.
+func filterBrokenLinks(linkList []links.Link) []links.Link {
+	broken := make([]links.Link, 0)
+	for _, link := range linkList {
+		if link.Broken() {
+			broken = append(broken, link)
+		}
+	}
+	return broken
+}
+
+func init() {
+	rootCmd.AddCommand(linksCmd)
+	rootCmd.AddCommand(backlinksCmd)
+	rootCmd.AddCommand(graphCmd)
+
+	linksCmd.Flags().Bool("broken", false, "Only show links that do not resolve to a note")
+	graphCmd.Flags().String("format", "dot", "Output format: dot or json")
+	graphCmd.Flags().StringP("output", "o", "", "Write the graph to a file instead of stdout")
+}
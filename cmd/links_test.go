@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestGraphCmd(t *testing.T) {
+	storage.SetDefaultStorage(&mockStorage{notes: []notes.Note{
+		{ID: "note1", Title: "Design \"Doc\"", Content: "Decided in [[ADR 1]] and [[missing]]."},
+		{ID: "note2", Title: "ADR 1", Content: "See [[note1]]."},
+	}})
+
+	tests := []struct {
+		format         string
+		expectedOutput []string
+		expectedError  string
+	}{
+		{format: "dot", expectedOutput: []string{`"note1" [label="Design \"Doc\""];`, `"note1" -> "note2";`, `"note2" -> "note1";`}},
+		{format: "json", expectedOutput: []string{`"source": "note1"`, `"target": "note2"`}},
+		{format: "svg", expectedError: "unknown graph format 'svg'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			cmd := cobra.Command{Use: "graph", RunE: graphCmd.RunE}
+			cmd.Flags().String("format", "dot", "")
+			cmd.Flags().StringP("output", "o", "", "")
+			output := new(bytes.Buffer)
+			cmd.SetOut(output)
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs([]string{"--format", tt.format})
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(output.String(), expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, output.String())
+				}
+			}
+			if strings.Contains(output.String(), "missing") {
+				t.Error("Expected broken links to be left out of the graph")
+			}
+		})
+	}
+}
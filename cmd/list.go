@@ -19,19 +19,19 @@ var listCmd = &cobra.Command{
 	Short: "list all notes",
 	Long: `List all notes:
 
+Pinned notes are listed first. Archived notes are hidden unless --archived or --all is given.
+
 Usage:
-simple-jot list`,
+simple-jot list
+simple-jot list --archived
+simple-jot list --all`,
 	Run: func(cmd *cobra.Command, args []string) {
 		noteList, err := storage.GetNotes()
 		if err != nil {
 			log.Fatal("cannot fetch notes. See error:", err.Error())
 		}
-		exNote := notes.Note{}
-		headers := exNote.GetHeaders()
-		dataFrame := make([][]string, len(noteList))
-		for i, n := range noteList {
-			dataFrame[i] = n.PrepRow()
-		}
+		noteList = notes.FilterNotesByArchive(noteList, archiveFilterFromFlags(cmd))
+		headers, dataFrame := noteTable(noteList)
 		table := tablewriter.NewWriter(os.Stdout)
 		table.Header(headers)
 		table.Bulk(dataFrame)
@@ -44,6 +44,7 @@ simple-jot list`,
 
 func init() {
 	rootCmd.AddCommand(listCmd)
+	addArchiveFlags(listCmd)
 
 	// Here you will define your flags and configuration settings.
 
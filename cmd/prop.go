@@ -0,0 +1,153 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// propCmd represents the prop command
+var propCmd = &cobra.Command{
+	Use:   "prop",
+	Short: "Manage custom properties on notes",
+	Long: `Manage custom key/value properties on notes, such as status, owner or ticket.
+Properties are shown as extra columns by 'list' and 'search', and can be searched with --prop.
+
+Usage:
+  simple-jot prop set <note> <key> <value>
+  simple-jot prop get <note> [key]
+  simple-jot prop unset <note> <key...>
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+// propSetCmd represents the set subcommand of prop
+var propSetCmd = &cobra.Command{
+	Use:   "set <note> <key> <value>",
+	Short: "Set a property on a note",
+	Long: `Set a property on a note, replacing its current value.
+
+Examples:
+  simple-jot prop set @ status open
+  simple-jot prop set q3-planning owner 'Sam Lee'
+`,
+	Args:              cobra.ExactArgs(3),
+	ValidArgsFunction: completePropArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, value := strings.TrimSpace(args[1]), strings.TrimSpace(args[2])
+		if err := notes.ValidatePropertyKey(key); err != nil {
+			return err
+		}
+		if value == "" {
+			return fmt.Errorf("property value cannot be empty. Use 'simple-jot prop unset' to remove a property")
+		}
+
+		return updateNoteProperties(cmd, args[0], func(n *notes.Note) error {
+			n.SetProperty(key, value)
+			cmd.Printf("Set %s=%s on %s\n", key, value, n.ID)
+			return nil
+		})
+	},
+}
+
+// propGetCmd represents the get subcommand of prop
+var propGetCmd = &cobra.Command{
+	Use:   "get <note> [key]",
+	Short: "Get the properties of a note",
+	Long: `Print the value of a property, or list all properties of the note when no key is given.
+
+Examples:
+  simple-jot prop get @ status
+  simple-jot prop get q3-planning
+`,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completePropArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, args[0])
+		if err != nil {
+			return err
+		}
+		note := noteList[idx]
+
+		if len(args) == 2 {
+			value, ok := note.GetProperty(args[1])
+			if !ok {
+				return fmt.Errorf("property '%s' is not set on note %s", args[1], note.ID)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), value)
+			return nil
+		}
+
+		if len(note.Properties) == 0 {
+			cmd.Printf("Note %s has no properties.\n", note.ID)
+			return nil
+		}
+		dataFrame := make([][]string, 0, len(note.Properties))
+		for _, key := range slices.Sorted(maps.Keys(note.Properties)) {
+			dataFrame = append(dataFrame, []string{key, note.Properties[key]})
+		}
+		return tabler.RenderTable(dataFrame, []string{"Key", "Value"})
+	},
+}
+
+// propUnsetCmd represents the unset subcommand of prop
+var propUnsetCmd = &cobra.Command{
+	Use:               "unset <note> <key...>",
+	Short:             "Remove properties from a note",
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completePropArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateNoteProperties(cmd, args[0], func(n *notes.Note) error {
+			for _, key := range args[1:] {
+				if !n.UnsetProperty(key) {
+					return fmt.Errorf("property '%s' is not set on note %s", key, n.ID)
+				}
+				cmd.Printf("Removed %s from %s\n", key, n.ID)
+			}
+			return nil
+		})
+	},
+}
+
+// updateNoteProperties applies update to the note referred to by ref and saves the notes.
+func updateNoteProperties(cmd *cobra.Command, ref string, update func(n *notes.Note) error) error {
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		return fmt.Errorf("cannot fetch notes: %w", err)
+	}
+	idx, err := resolveNote(noteList, ref)
+	if err != nil {
+		return err
+	}
+
+	if err := update(&noteList[idx]); err != nil {
+		return err
+	}
+	if err := storage.SaveNotes(noteList); err != nil {
+		return fmt.Errorf("cannot save notes: %w", err)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(propCmd)
+
+	propCmd.AddCommand(propSetCmd)
+	propCmd.AddCommand(propGetCmd)
+	propCmd.AddCommand(propUnsetCmd)
+}
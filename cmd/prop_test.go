@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bytes"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestPropCmd(t *testing.T) {
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		command            *cobra.Command
+		args               []string
+		expectedOutput     string
+		expectedError      string
+		expectedProperties map[string]string
+	}{
+		{
+			name:               "Set a property",
+			command:            propSetCmd,
+			args:               []string{"note1", "owner", "sam"},
+			expectedOutput:     "Set owner=sam on note1",
+			expectedProperties: map[string]string{"status": "open", "owner": "sam"},
+		},
+		{
+			name:               "Replace a property",
+			command:            propSetCmd,
+			args:               []string{"Ticket", "status", "done"},
+			expectedProperties: map[string]string{"status": "done"},
+		},
+		{
+			name:          "Reserved property name",
+			command:       propSetCmd,
+			args:          []string{"note1", "title", "x"},
+			expectedError: "cannot be used as a property name",
+		},
+		{
+			name:           "Get a property",
+			command:        propGetCmd,
+			args:           []string{"note1", "status"},
+			expectedOutput: "open\n",
+		},
+		{
+			name:          "Get a missing property",
+			command:       propGetCmd,
+			args:          []string{"note1", "owner"},
+			expectedError: "property 'owner' is not set on note note1",
+		},
+		{
+			name:               "Unset a property",
+			command:            propUnsetCmd,
+			args:               []string{"note1", "status"},
+			expectedProperties: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockStorage{notes: []notes.Note{
+				{ID: "note1", Title: "Ticket", Properties: map[string]string{"status": "open"}},
+			}}
+			storage.SetDefaultStorage(mock)
+
+			cmd := cobra.Command{Use: tt.command.Use, Args: tt.command.Args, RunE: tt.command.RunE}
+			output := new(bytes.Buffer)
+			cmd.SetOut(output)
+			cmd.SetErr(output)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if !strings.Contains(output.String(), tt.expectedOutput) {
+				t.Errorf("Expected output to contain %q, got %q", tt.expectedOutput, output.String())
+			}
+			if tt.command != propGetCmd && !maps.Equal(mock.notes[0].Properties, tt.expectedProperties) {
+				t.Errorf("Expected properties %v, got %v", tt.expectedProperties, mock.notes[0].Properties)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// newProvider returns the LLM provider selected by the ai_* config keys. Requests time out after
+// ai_timeout and are retried up to ai_max_retries times.
+func newProvider() (ai.Provider, error) {
+	cfg := config.GetConfig()
+	isGemini := cfg.AIProvider == "" || cfg.AIProvider == ai.ProviderGemini
+
+	apiKey := cfg.AIAPIKey
+	if apiKey == "" && isGemini {
+		apiKey = cfg.GeminiAPIKey
+	}
+	if apiKey == "" && isGemini {
+		return nil, fmt.Errorf("gemini API key not configured. Please set it using 'simple-jot config set gemini-api-key <YOUR_API_KEY>' or choose another provider with 'simple-jot config set ai-provider <provider>'")
+	}
+
+	provider, err := ai.NewProvider(ai.ProviderConfig{
+		Provider: cfg.AIProvider,
+		Model:    cfg.AIModel,
+		APIKey:   apiKey,
+		BaseURL:  cfg.AIBaseURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	maxRetries := ai.DefaultMaxRetries
+	if cfg.AIMaxRetries != nil {
+		maxRetries = *cfg.AIMaxRetries
+	}
+	return ai.WithRetry(provider, ai.RetryPolicy{
+		Timeout:    cfg.AITimeout,
+		MaxRetries: maxRetries,
+	}), nil
+}
+
+// semanticSearchOptions returns the chunking and caching settings for ai.SemanticSearch from the
+// config. Results are cached in the data directory unless --no-cache is set.
+func semanticSearchOptions(cmd *cobra.Command) ai.SearchOptions {
+	cfg := config.GetConfig()
+	opts := ai.SearchOptions{
+		MaxPromptTokens: cfg.AIMaxTokens,
+		Concurrency:     cfg.AIConcurrency,
+	}
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); !noCache {
+		opts.Cache = ai.NewCache(filepath.Join(cfg.DataDir, "cache"), cfg.AICacheTTL)
+	}
+	return opts
+}
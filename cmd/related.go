@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/related"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// maxRelatedQueryLength caps how much of the note is used as the semantic search query.
+const maxRelatedQueryLength = 1000
+
+// relatedCmd represents the related command
+var relatedCmd = &cobra.Command{
+	Use:   "related <note>",
+	Short: "Show notes related to a note",
+	Long: `Rank other notes by how closely they relate to a note, using shared tags, full-text
+similarity and, when an LLM provider is configured, semantic similarity. Each suggestion
+lists the reasons it was picked.
+
+Examples:
+  simple-jot related <note>
+  simple-jot related <note> --limit 5 --offline
+`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteRef := args[0]
+		limit, _ := cmd.Flags().GetInt("limit")
+		offline, _ := cmd.Flags().GetBool("offline")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, noteRef)
+		if err != nil {
+			return err
+		}
+		target := noteList[idx]
+
+		var semantic map[string]float64
+		if !offline {
+			semantic = semanticNeighbours(cmd, target, noteList, limit)
+		}
+
+		recommendations, err := related.Rank(target, noteList, semantic, limit)
+		if err != nil {
+			return fmt.Errorf("failed to rank related notes: %w", err)
+		}
+		if len(recommendations) == 0 {
+			cmd.Println("No related notes found.")
+			return nil
+		}
+
+		headers := []string{"ID", "Title", "Score", "Why"}
+		dataFrame := make([][]string, len(recommendations))
+		for i, rec := range recommendations {
+			dataFrame[i] = rec.PrepRow()
+		}
+		if err := tabler.RenderTable(dataFrame, headers); err != nil {
+			return fmt.Errorf("failed to render table: %w", err)
+		}
+		return nil
+	},
+}
+
+// semanticNeighbours asks the configured LLM provider which notes are about the same topic as
+// target. It returns nil when no provider is configured or the request fails.
+func semanticNeighbours(cmd *cobra.Command, target notes.Note, noteList []notes.Note, limit int) map[string]float64 {
+	provider, err := newProvider()
+	if err != nil {
+		return nil
+	}
+
+	others := make([]notes.Note, 0, len(noteList))
+	for _, n := range noteList {
+		if n.ID != target.ID {
+			others = append(others, n)
+		}
+	}
+	query := "Find notes about the same topic as this note.\nTitle: " + target.Title + "\nContent: " + target.Content
+	if len(query) > maxRelatedQueryLength {
+		query = strings.ToValidUTF8(query[:maxRelatedQueryLength], "")
+	}
+
+	opts := semanticSearchOptions(cmd)
+	opts.Limit = 2 * limit
+	results, err := ai.SemanticSearch(cmd.Context(), provider, ai.DocumentsFromNotes(others), query, opts)
+	if err != nil {
+		cmd.PrintErrf("Warning: semantic similarity unavailable: %v\n", err)
+		return nil
+	}
+
+	scores := make(map[string]float64, len(results))
+	for _, res := range results {
+		scores[res.PrimaryKey] = res.Score
+	}
+	return scores
+}
+
+func init() {
+	rootCmd.AddCommand(relatedCmd)
+
+	relatedCmd.Flags().IntP("limit", "l", 10, "Maximum number of related notes to show")
+	relatedCmd.Flags().Bool("offline", false, "Skip semantic similarity even if an LLM provider is configured")
+}
@@ -0,0 +1,12 @@
+package cmd
+
+import (
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+// resolveNote returns the index in noteList of the note referred to by ref: a full ID, a unique
+// ID prefix, a title or slug, or "@" for the active note. Every note argument goes through it.
+func resolveNote(noteList []notes.Note, ref string) (int, error) {
+	return notes.Resolve(noteList, ref, config.GetConfig().ActiveNote)
+}
@@ -4,8 +4,10 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 
 	"github.com/landanqrew/simple-jot/internal/config"
 	"github.com/spf13/cobra"
@@ -20,6 +22,9 @@ var rootCmd = &cobra.Command{
 	Short: "A simple cli tool for taking and managing notes",
 	Long: `simple-jot is a simple cli tool for taking and managing notes within the context of a project.
 
+Wherever a note is expected you can give its full ID, a unique ID prefix (at least 4 characters),
+its title or slugified title (e.g. q3-planning), or @ for the active note.
+
 ## Usage Examples: ##
 
 to get started, run:
@@ -42,6 +47,13 @@ to view your configured Gemini API Key:
 
 	simple-jot config get gemini-api-key
 
+to use an OpenAI-compatible endpoint or a local Ollama server instead of Gemini:
+
+	simple-jot config set ai-provider openai (or ollama)
+	simple-jot config set ai-model <model>
+	simple-jot config set ai-base-url <url>
+	simple-jot config set ai-api-key <YOUR_API_KEY>
+
 to create a new note, run:
 
 	simple-jot create <note-name> -n "<note-content>" -s (optional - will set the note configuration to the new note)
@@ -58,13 +70,29 @@ to run a semantic search with llm agent, run:
 
 	simple-jot search --semantic <query>
 
+to ask a question answered from your notes, run:
+
+	simple-jot ask "<question>" --show-sources
+
+to list the notes linking to a note with [[note]], run:
+
+	simple-jot backlinks <note-id>
+
 to edit a note, run:
 
 	simple-jot edit <note-id> -n "<note-content>"
 
+to create a note from a template, run:
+
+	simple-jot create <note-name> --template <template-name>
+
+to add an entry to today's journal note, run:
+
+	simple-jot today "<text>"
+
 to tag a note, run:
 
-	simple-jot tag <note-id> (optional - will default to the current note) <tag>
+	simple-jot tag add <note-id> (optional - will default to the current note) <tag...>
 
 to delete a note, run:
 
@@ -74,7 +102,10 @@ to delete a note, run:
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
+	// Ctrl-C cancels in-flight AI requests instead of leaving them running
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
@@ -88,6 +119,7 @@ func init() {
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.simple-jot.yaml)")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Do not read or write cached AI results")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// searchSaveCmd represents the save subcommand of search
+var searchSaveCmd = &cobra.Command{
+	Use:   "save <name> [query]",
+	Short: "Save a named search",
+	Long: `Save a combination of search flags under a name so it can be re-run with 'simple-jot search run'.
+A bare query without flags is saved as a content search. Saving over an existing name replaces it.
+
+Examples:
+  simple-jot search save standup --tag standup --date-start 2025-01-01
+  simple-jot search save cache-notes 'cache ttl'
+`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		query := searchQueryFromFlags(cmd)
+		if len(args) == 2 {
+			if query.Content != "" {
+				return fmt.Errorf("cannot provide a query argument together with the --content flag. Please choose one")
+			}
+			query.Content = args[1]
+		}
+		if query.IsEmpty() {
+			return fmt.Errorf("saved search cannot be empty. Please provide a query or at least one search flag")
+		}
+
+		cfg := config.GetConfig()
+		if cfg.SavedSearches == nil {
+			cfg.SavedSearches = make(map[string]config.SearchQuery)
+		}
+		cfg.SavedSearches[name] = query
+		if err := writeSavedSearches(cfg.SavedSearches); err != nil {
+			return err
+		}
+
+		cmd.Printf("Saved search '%s'.\n", name)
+		return nil
+	},
+}
+
+// searchRunCmd represents the run subcommand of search
+var searchRunCmd = &cobra.Command{
+	Use:   "run <name>",
+	Short: "Run a saved search",
+	Long: `Run a search saved with 'simple-jot search save'. Any search flags given here
+are applied on top of the saved filters and narrow the results further.
+
+Examples:
+  simple-jot search run standup
+  simple-jot search run standup --content 'blocked'
+`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSavedSearchArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		saved, ok := config.GetConfig().SavedSearches[name]
+		if !ok {
+			return fmt.Errorf("saved search '%s' not found", name)
+		}
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+
+		return runSearch(cmd, noteList, saved, searchQueryFromFlags(cmd))
+	},
+}
+
+// searchListCmd represents the list subcommand of search
+var searchListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved searches",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		saved := config.GetConfig().SavedSearches
+		if len(saved) == 0 {
+			cmd.Println("No saved searches found.")
+			return nil
+		}
+
+		headers := []string{"Name", "Semantic", "Vector", "Hybrid", "Content", "Tag", "Prop", "DateStart", "DateEnd"}
+		dataFrame := make([][]string, 0, len(saved))
+		for _, name := range slices.Sorted(maps.Keys(saved)) {
+			q := saved[name]
+			tag := q.Tag
+			if q.ExactTag {
+				tag += " (exact)"
+			}
+			dataFrame = append(dataFrame, []string{name, q.Semantic, q.Vector, q.Hybrid, q.Content, tag, q.Prop, q.DateStart, q.DateEnd})
+		}
+
+		err := tabler.RenderTable(dataFrame, headers)
+		if err != nil {
+			return fmt.Errorf("failed to render table: %w", err)
+		}
+		return nil
+	},
+}
+
+// searchDeleteCmd represents the delete subcommand of search
+var searchDeleteCmd = &cobra.Command{
+	Use:               "delete <name>",
+	Short:             "Delete a saved search",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeSavedSearchArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		cfg := config.GetConfig()
+		if _, ok := cfg.SavedSearches[name]; !ok {
+			return fmt.Errorf("saved search '%s' not found", name)
+		}
+
+		delete(cfg.SavedSearches, name)
+		if err := writeSavedSearches(cfg.SavedSearches); err != nil {
+			return err
+		}
+
+		cmd.Printf("Deleted saved search '%s'.\n", name)
+		return nil
+	},
+}
+
+// completeSavedSearchArg completes the name of a saved search.
+func completeSavedSearchArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return slices.Sorted(maps.Keys(config.GetConfig().SavedSearches)), cobra.ShellCompDirectiveNoFileComp
+}
+
+// writeSavedSearches replaces the saved_searches section of the config file.
+func writeSavedSearches(saved map[string]config.SearchQuery) error {
+	raw := make(map[string]map[string]string, len(saved))
+	for name, q := range saved {
+		raw[name] = q.ToMap()
+	}
+	viper.Set("saved_searches", raw)
+	return saveConfig()
+}
+
+func init() {
+	searchCmd.AddCommand(searchSaveCmd)
+	searchCmd.AddCommand(searchRunCmd)
+	searchCmd.AddCommand(searchListCmd)
+	searchCmd.AddCommand(searchDeleteCmd)
+
+	addSearchFlags(searchSaveCmd)
+	addSearchFlags(searchRunCmd)
+	addArchiveFlags(searchRunCmd)
+}
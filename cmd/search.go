@@ -21,6 +21,7 @@ var searchCmd = &cobra.Command{
 	Use:   "search",
 	Short: "Search for notes",
 	Long: `Search for notes using various criteria. You can search by content, tags, date range, or perform semantic search.
+Semantic, vector and hybrid searches only rank the notes that match the other filters.
 
 Examples:
   # Search by date range
@@ -28,12 +29,32 @@ Examples:
   
   # Search by tag
   simple-jot search --tag 'tag1,tag2'
+
+  # Search a tag hierarchy: project/api matches project/api/auth too, unless --exact is given
+  simple-jot search --tag project/api
+  simple-jot search --tag project/api --exact
+
+  # Search by property
+  simple-jot search --prop status=open --prop owner
   
   # Search by content (case-insensitive)
   simple-jot search --content 'your search term'
   
   # Semantic search with AI
   simple-jot search --semantic 'programming concepts'
+
+  # Offline semantic search using the local vector index
+  simple-jot search --vector 'programming concepts'
+
+  # Semantic search limited to tagged notes from this year
+  simple-jot search --semantic 'deadlines' --tag work --date-start 2025-01-01
+
+  # Hybrid search fusing full-text and semantic rankings
+  simple-jot search --hybrid 'cache invalidation'
+
+  # Save a search and run it later, optionally narrowing it further
+  simple-jot search save standup --tag standup --date-start 2025-01-01
+  simple-jot search run standup --content 'blocked'
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		noteList, err := storage.GetNotes()
@@ -41,119 +62,294 @@ Examples:
 			return fmt.Errorf("cannot fetch notes: %w", err)
 		}
 
-		// Get flag values
-		semanticSearch, _ := cmd.Flags().GetString("semantic")
-		contentSearch, _ := cmd.Flags().GetString("content")
-		tagStr, _ := cmd.Flags().GetString("tag")
-		dsStr, _ := cmd.Flags().GetString("date-start")
-		deStr, _ := cmd.Flags().GetString("date-end")
-
-		filteredNotes := noteList
-
-		if semanticSearch != "" {
-			cfg := config.GetConfig()
-			geminiAPIKey := cfg.GeminiAPIKey
-			if geminiAPIKey == "" {
-				cmd.PrintErr("Error: Gemini API Key is not set. Please set it using 'simple-jot config set gemini-api-key <YOUR_API_KEY>'\n")
-				return fmt.Errorf("gemini API key not configured")
-			}
-			cmd.Println("Performing semantic search with Gemini API...")
-			cmd.Printf("Query: %s\n", semanticSearch)
-			// TODO: Implement actual Gemini API call here
-			searchResults, err := ai.SemanticSearch(noteList, semanticSearch, geminiAPIKey)
-			if err != nil {
-				return fmt.Errorf("failed to perform semantic search: %w", err)
-			}
-			searchDF := make([][]string, len(searchResults))
-			noteStore := make(map[string]notes.Note)
-			for _, note := range noteList {
-				noteStore[note.ID] = note
-			}
+		return runSearch(cmd, noteList, searchQueryFromFlags(cmd))
+	},
+}
 
-			headers := []string{"ID", "Score", "Content"}
-			for i, result := range searchResults {
-				lookupNote, ok := noteStore[result.PrimaryKey]
-				if !ok {
-					return fmt.Errorf("failed to get note with id (%s): %w", result.PrimaryKey, err)
-				}
-				searchDF[i] = result.PrepRow()
-				// fmt.Println(searchDF[i], lookupNote.Content)
-				searchDF[i] = append(searchDF[i], lookupNote.Content)
-			}
-			err = tabler.RenderTable(searchDF, headers)
-			if err != nil {
-				return fmt.Errorf("failed to render table: %w", err)
-			}
-			return nil
-		}
+// addSearchFlags registers the search filter flags on cmd.
+func addSearchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("semantic", "s", "", "Perform a semantic search using the configured LLM provider")
+	cmd.Flags().String("vector", "", "Perform an offline semantic search using the local vector index")
+	cmd.Flags().String("hybrid", "", "Rank notes by full-text and semantic relevance combined with reciprocal rank fusion")
+	cmd.Flags().StringP("content", "c", "", "Search notes by content")
+	cmd.Flags().StringP("tag", "t", "", "Search notes by tag and the tags below it, e.g. project/api (comma-separated for multiple tags)")
+	cmd.Flags().Bool("exact", false, "Match --tag exactly instead of including the tags below it")
+	cmd.Flags().StringSlice("prop", nil, "Search notes by property, as key=value or just key to require the property (repeatable)")
+	registerTagFlagCompletion(cmd)
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("prop", completePropertyFilter))
+	cmd.Flags().StringP("date-start", "f", "", "Search notes by date start (format: YYYY-MM-DD)")
+	cmd.Flags().StringP("date-end", "u", "", "Search notes by date end (format: YYYY-MM-DD)")
+}
+
+// searchQueryFromFlags reads the filters registered by addSearchFlags.
+func searchQueryFromFlags(cmd *cobra.Command) config.SearchQuery {
+	semanticSearch, _ := cmd.Flags().GetString("semantic")
+	vectorSearch, _ := cmd.Flags().GetString("vector")
+	hybridSearch, _ := cmd.Flags().GetString("hybrid")
+	contentSearch, _ := cmd.Flags().GetString("content")
+	tagStr, _ := cmd.Flags().GetString("tag")
+	exactTag, _ := cmd.Flags().GetBool("exact")
+	propFilters, _ := cmd.Flags().GetStringSlice("prop")
+	dsStr, _ := cmd.Flags().GetString("date-start")
+	deStr, _ := cmd.Flags().GetString("date-end")
 
-		if contentSearch != "" {
-			filteredNotes = notes.FilterNotesByContent(filteredNotes, contentSearch)
+	return config.SearchQuery{
+		Semantic:  semanticSearch,
+		Vector:    vectorSearch,
+		Hybrid:    hybridSearch,
+		Content:   contentSearch,
+		Tag:       tagStr,
+		ExactTag:  exactTag,
+		Prop:      strings.Join(propFilters, ","),
+		DateStart: dsStr,
+		DateEnd:   deStr,
+	}
+}
+
+// runSearch applies each query in turn, so later queries narrow the results of earlier ones,
+// and renders the matching notes. A semantic, vector or hybrid query then ranks the notes that
+// passed the filters; when several queries set one, the last query wins.
+func runSearch(cmd *cobra.Command, noteList []notes.Note, queries ...config.SearchQuery) error {
+	filteredNotes := notes.FilterNotesByArchive(noteList, archiveFilterFromFlags(cmd))
+	for _, query := range queries {
+		filteredNotes = filterNotes(cmd, filteredNotes, query)
+	}
+
+	// Prepare table data
+	if len(filteredNotes) == 0 {
+		cmd.Println("No notes found matching the search criteria.")
+		return nil
+	}
+
+	var ranking config.SearchQuery
+	for _, query := range queries {
+		if query.Hybrid != "" || query.Semantic != "" || query.Vector != "" {
+			ranking = query
 		}
+	}
+	switch {
+	case ranking.Hybrid != "":
+		return runHybridSearch(cmd, noteList, filteredNotes, ranking.Hybrid)
+	case ranking.Semantic != "":
+		return runSemanticSearch(cmd, filteredNotes, ranking.Semantic)
+	case ranking.Vector != "":
+		return runVectorSearch(cmd, noteList, filteredNotes, ranking.Vector)
+	}
 
-		if tagStr != "" {
-			tagList := strings.Split(tagStr, ",")
-			tagMap := tags.TagMap{}
-			tagMap.BuildTagMap(noteList)
-			noteSet := make(map[string]notes.Note)
-			store := notes.NoteStore{}
-			store.BuildNoteMap(noteList)
-
-			for _, tagName := range tagList {
-				trimmedTag := strings.TrimSpace(tagName)
-				relatedNoteIDs := tagMap.GetNotesForTag(trimmedTag)
-				if len(relatedNoteIDs) == 0 {
-					cmd.PrintErrf("Error identified. No notes found for tag (%s)\n", trimmedTag)
-					continue
-				}
-				for _, noteID := range relatedNoteIDs {
-					n, err := store.GetNoteByID(noteID)
-					if err != nil {
-						cmd.PrintErrf("cannot find note for id (%s). See error: %s\n", noteID, err.Error())
-						continue
-					}
-					noteSet[noteID] = n
+	headers, dataFrame := noteTable(filteredNotes)
+
+	err := tabler.RenderTable(dataFrame, headers)
+	if err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+
+	return nil
+}
+
+// filterNotes returns the notes matching the content, tag and date filters of query.
+func filterNotes(cmd *cobra.Command, noteList []notes.Note, query config.SearchQuery) []notes.Note {
+	filteredNotes := noteList
+
+	if query.Content != "" {
+		filteredNotes = notes.FilterNotesByContent(filteredNotes, query.Content)
+	}
+
+	if query.Tag != "" {
+		tagList := strings.Split(query.Tag, ",")
+		normalizer := tagNormalizer()
+		tagMap := tags.TagMap{}
+		tagMap.BuildNormalizedTagMap(filteredNotes, normalizer)
+		tagTree := tagMap.Tree()
+		noteSet := make(map[string]notes.Note)
+		store := notes.NoteStore{}
+		store.BuildNoteMap(filteredNotes)
+
+		for _, tagName := range tagList {
+			trimmedTag := strings.TrimSpace(tagName)
+			normalizedTag := normalizer.Normalize(trimmedTag)
+			relatedNoteIDs := tagMap.GetNotesForTag(normalizedTag)
+			if !query.ExactTag {
+				// project/api also matches project/api/auth
+				if node := tagTree.Find(normalizedTag); node != nil {
+					relatedNoteIDs = node.SubtreeNoteIDs()
 				}
 			}
-			filteredNotes = make([]notes.Note, 0, len(noteSet))
-			for _, note := range noteSet {
-				filteredNotes = append(filteredNotes, note)
+			if len(relatedNoteIDs) == 0 {
+				cmd.PrintErrf("Error identified. No notes found for tag (%s)\n", trimmedTag)
+				continue
+			}
+			for _, noteID := range relatedNoteIDs {
+				n, err := store.GetNoteByID(noteID)
+				if err != nil {
+					cmd.PrintErrf("cannot find note for id (%s). See error: %s\n", noteID, err.Error())
+					continue
+				}
+				noteSet[noteID] = n
 			}
 		}
-
-		if dsStr != "" || deStr != "" {
-			filteredNotes = notes.FilterNotesByDate(filteredNotes, dsStr, deStr)
+		filteredNotes = make([]notes.Note, 0, len(noteSet))
+		for _, note := range noteSet {
+			filteredNotes = append(filteredNotes, note)
 		}
+	}
 
-		// Prepare table data
-		if len(filteredNotes) == 0 {
-			cmd.Println("No notes found matching the search criteria.")
-			return nil
+	if query.Prop != "" {
+		for _, filter := range strings.Split(query.Prop, ",") {
+			key, value, hasValue, err := notes.ParsePropertyFilter(filter)
+			if err != nil {
+				cmd.PrintErrf("Error identified. %v\n", err)
+				continue
+			}
+			filteredNotes = notes.FilterNotesByProperty(filteredNotes, key, value, hasValue)
 		}
+	}
 
-		dataFrame := make([][]string, len(filteredNotes))
-		headers := filteredNotes[0].GetHeaders()
+	if query.DateStart != "" || query.DateEnd != "" {
+		filteredNotes = notes.FilterNotesByDate(filteredNotes, query.DateStart, query.DateEnd)
+	}
 
-		for i, n := range filteredNotes {
-			dataFrame[i] = n.PrepRow()
-		}
+	return filteredNotes
+}
+
+// runSemanticSearch ranks noteList against query with the configured LLM provider and renders the results.
+func runSemanticSearch(cmd *cobra.Command, noteList []notes.Note, query string) error {
+	provider, err := newProvider()
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Performing semantic search with %s...\n", provider.Name())
+	cmd.Printf("Query: %s\n", query)
+	searchResults, err := ai.SemanticSearch(cmd.Context(), provider, ai.DocumentsFromNotes(noteList), query, semanticSearchOptions(cmd))
+	if err != nil {
+		return fmt.Errorf("failed to perform semantic search: %w", err)
+	}
+	if len(searchResults) == 0 {
+		cmd.Println("No notes found matching the search criteria.")
+		return nil
+	}
+	return renderSearchResults(noteList, searchResults)
+}
+
+// runVectorSearch ranks candidates against query using the local vector index and renders the
+// results. noteList is the full note list, used to keep the index in sync.
+func runVectorSearch(cmd *cobra.Command, noteList []notes.Note, candidates []notes.Note, query string) error {
+	searchResults, err := vectorSearch(noteList, candidates, query, ai.DefaultSearchLimit)
+	if err != nil {
+		return err
+	}
+	if len(searchResults) == 0 {
+		cmd.Println("No notes found matching the search criteria.")
+		return nil
+	}
+	return renderSearchResults(noteList, searchResults)
+}
+
+// vectorSearch ranks candidates against query using the local vector index. The index is synced
+// with noteList, which must hold every note so that filtered-out notes are not dropped from it.
+func vectorSearch(noteList []notes.Note, candidates []notes.Note, query string, limit int) ([]ai.SearchResponse, error) {
+	index, embedder, err := loadVectorIndex()
+	if err != nil {
+		return nil, err
+	}
+	// embed any notes that are missing from the index or changed outside of create/edit
+	if err := index.Sync(embedder, noteList); err != nil {
+		return nil, err
+	}
+	if err := index.Save(vectorIndexPath()); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(candidates))
+	for i, n := range candidates {
+		ids[i] = n.ID
+	}
+	searchResults, err := index.Search(embedder, query, limit, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform vector search: %w", err)
+	}
+	return searchResults, nil
+}
+
+// runHybridSearch ranks candidates by full-text relevance and by semantic relevance, fuses the
+// two rankings and renders the results with both scores. Semantic relevance comes from the
+// configured LLM provider, or from the local vector index when no provider is configured.
+func runHybridSearch(cmd *cobra.Command, noteList []notes.Note, candidates []notes.Note, query string) error {
+	// rank deeper than the results shown so notes found by only one ranking can still make it
+	depth := 2 * ai.DefaultSearchLimit
+	docs := ai.DocumentsFromNotes(candidates)
+	textResults := ai.RankByText(docs, query, depth)
 
-		err = tabler.RenderTable(dataFrame, headers)
+	var semanticResults []ai.SearchResponse
+	provider, err := newProvider()
+	if err == nil {
+		cmd.Printf("Performing hybrid search with %s...\n", provider.Name())
+		opts := semanticSearchOptions(cmd)
+		opts.Limit = depth
+		semanticResults, err = ai.SemanticSearch(cmd.Context(), provider, docs, query, opts)
+		if err != nil {
+			return fmt.Errorf("failed to perform semantic search: %w", err)
+		}
+	} else {
+		cmd.PrintErrf("No LLM provider available (%v), using the local vector index.\n", err)
+		semanticResults, err = vectorSearch(noteList, candidates, query, depth)
 		if err != nil {
-			return fmt.Errorf("failed to render table: %w", err)
+			return err
 		}
+	}
 
+	results := ai.FuseRankings(textResults, semanticResults, ai.DefaultSearchLimit)
+	if len(results) == 0 {
+		cmd.Println("No notes found matching the search criteria.")
 		return nil
-	},
+	}
+
+	noteStore := make(map[string]notes.Note)
+	for _, note := range candidates {
+		noteStore[note.ID] = note
+	}
+	headers := []string{"ID", "Score", "Text", "Semantic", "Content"}
+	dataFrame := make([][]string, 0, len(results))
+	for _, result := range results {
+		lookupNote, ok := noteStore[result.PrimaryKey]
+		if !ok {
+			continue
+		}
+		dataFrame = append(dataFrame, append(result.PrepRow(), lookupNote.Content))
+	}
+	if err := tabler.RenderTable(dataFrame, headers); err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+	return nil
+}
+
+// renderSearchResults renders ranked search results alongside the content of each note.
+// Results that do not refer to a known note are skipped.
+func renderSearchResults(noteList []notes.Note, searchResults []ai.SearchResponse) error {
+	searchDF := make([][]string, 0, len(searchResults))
+	noteStore := make(map[string]notes.Note)
+	for _, note := range noteList {
+		noteStore[note.ID] = note
+	}
+
+	headers := []string{"ID", "Score", "Content"}
+	for _, result := range searchResults {
+		lookupNote, ok := noteStore[result.PrimaryKey]
+		if !ok {
+			continue
+		}
+		searchDF = append(searchDF, append(result.PrepRow(), lookupNote.Content))
+	}
+	err := tabler.RenderTable(searchDF, headers)
+	if err != nil {
+		return fmt.Errorf("failed to render table: %w", err)
+	}
+	return nil
 }
 
 func init() {
 	rootCmd.AddCommand(searchCmd)
 
 	// Define flags
-	searchCmd.Flags().StringP("semantic", "s", "", "Perform a semantic search using Gemini")
-	searchCmd.Flags().StringP("content", "c", "", "Search notes by content")
-	searchCmd.Flags().StringP("tag", "t", "", "Search notes by tag (comma-separated for multiple tags)")
-	searchCmd.Flags().StringP("date-start", "f", "", "Search notes by date start (format: YYYY-MM-DD)")
-	searchCmd.Flags().StringP("date-end", "u", "", "Search notes by date end (format: YYYY-MM-DD)")
+	addSearchFlags(searchCmd)
+	addArchiveFlags(searchCmd)
 }
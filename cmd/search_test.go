@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/landanqrew/simple-jot/internal/config"
 	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/spf13/cobra"
 )
 
 // Note: The search command has been refactored to properly use Cobra flags instead of manual argument parsing.
@@ -194,3 +197,90 @@ func TestSearchFiltering(t *testing.T) {
 	})
 		*/
 }
+
+func TestFilterNotesNarrowsSavedSearch(t *testing.T) {
+	mockNotes := []notes.Note{
+		{ID: "note1", Content: "standup: blocked on review", Tags: []string{"standup"}, CreatedAt: "2025-01-15 10:00:00"},
+		{ID: "note2", Content: "standup: all good", Tags: []string{"standup"}, CreatedAt: "2025-01-16 10:00:00"},
+		{ID: "note3", Content: "retro: blocked by infra", Tags: []string{"retro"}, CreatedAt: "2025-01-17 10:00:00"},
+	}
+	cmd := &cobra.Command{}
+
+	saved := config.SearchQuery{Tag: "standup"}
+	filtered := filterNotes(cmd, mockNotes, saved)
+	if len(filtered) != 2 {
+		t.Fatalf("Expected 2 notes for saved query, got %d", len(filtered))
+	}
+
+	extra := config.SearchQuery{Content: "blocked"}
+	filtered = filterNotes(cmd, filtered, extra)
+	if len(filtered) != 1 || filtered[0].ID != "note1" {
+		t.Errorf("Expected only note1 after narrowing, got %v", filtered)
+	}
+}
+
+func TestVectorSearchRanksOnlyCandidates(t *testing.T) {
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+	mockNotes := []notes.Note{
+		{ID: "note1", Title: "Go", Content: "go concurrency with goroutines", Tags: []string{"work"}},
+		{ID: "note2", Title: "Go", Content: "go concurrency with channels", Tags: []string{"personal"}},
+		{ID: "note3", Title: "Cooking", Content: "pasta recipe", Tags: []string{"work"}},
+	}
+	candidates := filterNotes(&cobra.Command{}, mockNotes, config.SearchQuery{Tag: "work"})
+
+	results, err := vectorSearch(mockNotes, candidates, "go concurrency", 10)
+	if err != nil {
+		t.Fatalf("vectorSearch returned an error: %v", err)
+	}
+	if len(results) != 1 || results[0].PrimaryKey != "note1" {
+		t.Errorf("Expected only the tagged match note1, got %v", results)
+	}
+
+	// filtering must not prune the other notes from the index
+	index, _, err := loadVectorIndex()
+	if err != nil {
+		t.Fatalf("loadVectorIndex returned an error: %v", err)
+	}
+	if len(index.Entries) != len(mockNotes) {
+		t.Errorf("Expected %d indexed notes, got %d", len(mockNotes), len(index.Entries))
+	}
+}
+
+func TestFilterNotesTagHierarchy(t *testing.T) {
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+	mockNotes := []notes.Note{
+		{ID: "note1", Tags: []string{"project/api/auth"}},
+		{ID: "note2", Tags: []string{"project/api"}},
+		{ID: "note3", Tags: []string{"project/web"}},
+		{ID: "note4", Tags: []string{"project/api-docs"}},
+	}
+
+	tests := []struct {
+		name     string
+		query    config.SearchQuery
+		expected []string
+	}{
+		{name: "Subtree", query: config.SearchQuery{Tag: "project/api"}, expected: []string{"note1", "note2"}},
+		{name: "Exact", query: config.SearchQuery{Tag: "project/api", ExactTag: true}, expected: []string{"note2"}},
+		{name: "Top level", query: config.SearchQuery{Tag: "project"}, expected: []string{"note1", "note2", "note3", "note4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := filterNotes(&cobra.Command{}, mockNotes, tt.query)
+			ids := make([]string, 0, len(filtered))
+			for _, n := range filtered {
+				ids = append(ids, n.ID)
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/fatih/color"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/osutils"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// defaultShowWidth is the wrap width used when the output is not a terminal.
+const defaultShowWidth = 100
+
+// showCmd represents the show command
+var showCmd = &cobra.Command{
+	Use:   "show <note>",
+	Short: "Show a note in full",
+	Long: `Show a single note with its title, tags and timestamps, followed by its content rendered
+as Markdown: headings, lists and code blocks with syntax highlighting. Notes taller than the
+terminal are paged through $PAGER (default "less -R").
+
+Examples:
+  simple-jot show <note>
+  simple-jot show <note> --raw
+  simple-jot show <note> --no-pager
+`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteRef := args[0]
+		raw, _ := cmd.Flags().GetBool("raw")
+		noPager, _ := cmd.Flags().GetBool("no-pager")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, noteRef)
+		if err != nil {
+			return err
+		}
+		note := noteList[idx]
+
+		var output string
+		if raw {
+			output = formatNoteRaw(note)
+		} else {
+			output, err = renderNoteMarkdown(note, osutils.TerminalWidth(cmd.OutOrStdout(), defaultShowWidth))
+			if err != nil {
+				return err
+			}
+		}
+
+		if noPager {
+			fmt.Fprint(cmd.OutOrStdout(), output)
+			return nil
+		}
+		return osutils.Page(cmd.OutOrStdout(), output)
+	},
+}
+
+// formatNoteRaw returns the note header and content as plain text.
+func formatNoteRaw(note notes.Note) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", note.Title)
+	fmt.Fprintf(&b, "ID: %s\n", note.ID)
+	fmt.Fprintf(&b, "Tags: %s\n", strings.Join(note.Tags, ", "))
+	for _, key := range slices.Sorted(maps.Keys(note.Properties)) {
+		fmt.Fprintf(&b, "%s: %s\n", key, note.Properties[key])
+	}
+	if note.Due != "" {
+		fmt.Fprintf(&b, "Due: %s\n", note.Due)
+	}
+	if states := note.States(); len(states) > 0 {
+		fmt.Fprintf(&b, "State: %s\n", strings.Join(states, ", "))
+	}
+	fmt.Fprintf(&b, "Created: %s\n", note.CreatedAt)
+	fmt.Fprintf(&b, "Updated: %s\n\n", note.UpdatedAt)
+	b.WriteString(note.Content)
+	if !strings.HasSuffix(note.Content, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderNoteMarkdown returns a header with the note's title, tags and timestamps followed by its
+// content rendered as Markdown, wrapped at width columns. Colours are only used on a terminal.
+func renderNoteMarkdown(note notes.Note, width int) (string, error) {
+	bold := color.New(color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  %s\n", color.New(color.Bold, color.FgCyan).Sprint(note.Title))
+	fmt.Fprintf(&b, "  %s %s\n", bold("ID:"), note.ID)
+	if len(note.Tags) > 0 {
+		fmt.Fprintf(&b, "  %s %s\n", bold("Tags:"), strings.Join(note.Tags, ", "))
+	}
+	for _, key := range slices.Sorted(maps.Keys(note.Properties)) {
+		fmt.Fprintf(&b, "  %s %s\n", bold(key+":"), note.Properties[key])
+	}
+	if note.Due != "" {
+		fmt.Fprintf(&b, "  %s %s\n", bold("Due:"), note.Due)
+	}
+	if states := note.States(); len(states) > 0 {
+		fmt.Fprintf(&b, "  %s %s\n", bold("State:"), strings.Join(states, ", "))
+	}
+	fmt.Fprintf(&b, "  %s\n", faint(fmt.Sprintf("Created %s · Updated %s", note.CreatedAt, note.UpdatedAt)))
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create markdown renderer: %w", err)
+	}
+	rendered, err := renderer.Render(note.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+	b.WriteString(rendered)
+	return b.String(), nil
+}
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+
+	showCmd.Flags().Bool("raw", false, "Print the note as plain text without Markdown rendering")
+	showCmd.Flags().Bool("no-pager", false, "Do not page long notes through $PAGER")
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestShowCmd(t *testing.T) {
+	note := notes.Note{
+		ID:        "note1",
+		Title:     "Release checklist",
+		Tags:      []string{"release", "ops"},
+		Content:   "## Steps\n\n- tag the build\n- update the changelog\n",
+		CreatedAt: "2025-01-15 10:00:00",
+		UpdatedAt: "2025-01-16 10:00:00",
+	}
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectedError  string
+		expectedOutput []string
+	}{
+		{
+			name:           "raw output",
+			args:           []string{"note1", "--raw", "--no-pager"},
+			expectedOutput: []string{"Release checklist\n", "Tags: release, ops\n", "Created: 2025-01-15 10:00:00\n", "## Steps\n\n- tag the build"},
+		},
+		{
+			name:           "rendered output",
+			args:           []string{"note1", "--no-pager"},
+			expectedOutput: []string{"Release checklist", "release, ops", "tag the build"},
+		},
+		{
+			name:          "unknown note",
+			args:          []string{"missing", "--raw"},
+			expectedError: "no note matches 'missing'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage.SetDefaultStorage(&mockStorage{notes: []notes.Note{note}})
+
+			cmd := cobra.Command{
+				Use:  "show",
+				Args: showCmd.Args,
+				RunE: showCmd.RunE,
+			}
+			cmd.Flags().Bool("raw", false, "")
+			cmd.Flags().Bool("no-pager", false, "")
+			output := new(bytes.Buffer)
+			cmd.SetOut(output)
+			cmd.SetErr(output)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(output.String(), expected) {
+					t.Errorf("Expected output to contain %q, got %q", expected, output.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// noteState describes a command that sets or clears a state on notes.
+type noteState struct {
+	use   string // command name, e.g. pin
+	short string
+	done  string // past tense for messages, e.g. pinned
+	apply func(n *notes.Note) bool
+}
+
+// noteStates are the pin, archive and favorite commands. Changing a state does not touch
+// UpdatedAt, since the note itself is unchanged.
+var noteStates = []noteState{
+	{use: "pin", short: "Pin notes so they are listed first", done: "pinned", apply: func(n *notes.Note) bool { return setState(&n.Pinned, true) }},
+	{use: "unpin", short: "Unpin notes", done: "unpinned", apply: func(n *notes.Note) bool { return setState(&n.Pinned, false) }},
+	{use: "archive", short: "Archive notes, hiding them from list and search", done: "archived", apply: func(n *notes.Note) bool { return setState(&n.Archived, true) }},
+	{use: "unarchive", short: "Unarchive notes", done: "unarchived", apply: func(n *notes.Note) bool { return setState(&n.Archived, false) }},
+	{use: "fav", short: "Mark notes as favorites", done: "marked as favorite", apply: func(n *notes.Note) bool { return setState(&n.Favorite, true) }},
+	{use: "unfav", short: "Remove notes from favorites", done: "removed from favorites", apply: func(n *notes.Note) bool { return setState(&n.Favorite, false) }},
+}
+
+// setState sets *field to value and reports whether it changed.
+func setState(field *bool, value bool) bool {
+	changed := *field != value
+	*field = value
+	return changed
+}
+
+// newNoteStateCmd creates the command for s.
+func newNoteStateCmd(s noteState) *cobra.Command {
+	return &cobra.Command{
+		Use:               s.use + " <note...>",
+		Short:             s.short,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completeNoteArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			noteList, err := storage.GetNotes()
+			if err != nil {
+				return fmt.Errorf("cannot fetch notes: %w", err)
+			}
+
+			changed := false
+			for _, ref := range args {
+				idx, err := resolveNote(noteList, ref)
+				if err != nil {
+					return err
+				}
+				if s.apply(&noteList[idx]) {
+					changed = true
+					cmd.Printf("Note %s %s.\n", noteList[idx].ID, s.done)
+				} else {
+					cmd.Printf("Note %s is already %s.\n", noteList[idx].ID, s.done)
+				}
+			}
+
+			if !changed {
+				return nil
+			}
+			if err := storage.SaveNotes(noteList); err != nil {
+				return fmt.Errorf("cannot save notes: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// addArchiveFlags registers the flags read by archiveFilterFromFlags on cmd.
+func addArchiveFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("archived", false, "Only show archived notes")
+	cmd.Flags().Bool("all", false, "Show archived notes along with the others")
+}
+
+// archiveFilterFromFlags returns which notes to show according to --archived and --all. Archived
+// notes are hidden by default.
+func archiveFilterFromFlags(cmd *cobra.Command) notes.ArchiveFilter {
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		return notes.AllNotes
+	}
+	if archived, _ := cmd.Flags().GetBool("archived"); archived {
+		return notes.OnlyArchived
+	}
+	return notes.HideArchived
+}
+
+// noteTable returns the headers and rows for listing noteList: pinned notes first, with a State
+// column when any note is pinned, a favorite or archived, and a column per property.
+func noteTable(noteList []notes.Note) ([]string, [][]string) {
+	noteList = slices.Clone(noteList)
+	notes.SortPinnedFirst(noteList)
+
+	showStates := slices.ContainsFunc(noteList, func(n notes.Note) bool { return len(n.States()) > 0 })
+	propertyKeys := notes.PropertyKeys(noteList)
+
+	exNote := notes.Note{}
+	headers := exNote.GetHeadersWithProperties(propertyKeys)
+	if showStates {
+		headers = append(headers, "State")
+	}
+	dataFrame := make([][]string, len(noteList))
+	for i, n := range noteList {
+		dataFrame[i] = n.PrepRowWithProperties(propertyKeys)
+		if showStates {
+			dataFrame[i] = append(dataFrame[i], strings.Join(n.States(), ", "))
+		}
+	}
+	return headers, dataFrame
+}
+
+func init() {
+	for _, s := range noteStates {
+		rootCmd.AddCommand(newNoteStateCmd(s))
+	}
+}
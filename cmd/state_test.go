@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestNoteStateCmds(t *testing.T) {
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	mock := &mockStorage{notes: []notes.Note{
+		{ID: "note1", Title: "Plan"},
+		{ID: "note2", Title: "Old", Pinned: true},
+	}}
+	storage.SetDefaultStorage(mock)
+
+	run := func(use string, args ...string) (string, error) {
+		for _, s := range noteStates {
+			if s.use == use {
+				stateCmd := newNoteStateCmd(s)
+				cmd := cobra.Command{Use: stateCmd.Use, Args: stateCmd.Args, RunE: stateCmd.RunE}
+				output := new(bytes.Buffer)
+				cmd.SetOut(output)
+				cmd.SetErr(output)
+				cmd.SetArgs(args)
+				err := cmd.Execute()
+				return output.String(), err
+			}
+		}
+		t.Fatalf("No state command %q", use)
+		return "", nil
+	}
+
+	if _, err := run("pin", "Plan", "note2"); err != nil {
+		t.Fatalf("pin returned an error: %v", err)
+	}
+	if !mock.notes[0].Pinned || !mock.notes[1].Pinned {
+		t.Errorf("Expected both notes to be pinned, got %+v", mock.notes)
+	}
+
+	output, err := run("archive", "note2")
+	if err != nil {
+		t.Fatalf("archive returned an error: %v", err)
+	}
+	if !strings.Contains(output, "Note note2 archived.") || !mock.notes[1].Archived {
+		t.Errorf("Expected note2 to be archived, got output %q", output)
+	}
+
+	if _, err := run("fav", "missing"); err == nil {
+		t.Error("Expected an error for an unknown note")
+	}
+
+	if _, err := run("unpin", "note1"); err != nil {
+		t.Fatalf("unpin returned an error: %v", err)
+	}
+	if mock.notes[0].Pinned || mock.notes[0].UpdatedAt != "" {
+		t.Errorf("Expected note1 to be unpinned without touching UpdatedAt, got %+v", mock.notes[0])
+	}
+}
+
+func TestNoteTablePinnedFirst(t *testing.T) {
+	noteList := []notes.Note{
+		{ID: "note1", Title: "First"},
+		{ID: "note2", Title: "Second", Pinned: true, Favorite: true},
+	}
+
+	headers, rows := noteTable(noteList)
+	if headers[len(headers)-1] != "State" {
+		t.Fatalf("Expected a State column, got headers %v", headers)
+	}
+	if rows[0][0] != "note2" || rows[0][len(headers)-1] != "pinned, fav" {
+		t.Errorf("Expected pinned note2 first, got %v", rows)
+	}
+	if noteList[0].ID != "note1" {
+		t.Error("Expected noteTable not to reorder the given notes")
+	}
+
+	headers, _ = noteTable(noteList[:1])
+	if slices.Contains(headers, "State") {
+		t.Errorf("Expected no State column without states, got %v", headers)
+	}
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/osutils"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/internal/tags"
+	"github.com/spf13/cobra"
+)
+
+// suggestTagsCmd represents the suggest-tags command
+var suggestTagsCmd = &cobra.Command{
+	Use:   "suggest-tags <note>",
+	Short: "Suggest tags and a better title for a note using the configured LLM",
+	Long: `Send a note and your existing tag vocabulary to the configured LLM provider and propose tags,
+preferring tags you already use, plus an optional better title. Suggestions are only applied
+after you confirm them, or straight away with --yes.
+
+Examples:
+  simple-jot suggest-tags <note>
+  simple-jot suggest-tags <note> --yes
+`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteRef := args[0]
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, noteRef)
+		if err != nil {
+			return err
+		}
+
+		changed, err := autoTagNote(cmd, &noteList[idx], noteList, yes)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+
+		if err := storage.SaveNotes(noteList); err != nil {
+			return fmt.Errorf("cannot save notes: %w", err)
+		}
+		updateVectorIndex(cmd, []notes.Note{noteList[idx]})
+		cmd.Println("Note updated successfully.")
+		return nil
+	},
+}
+
+// autoTagFlags returns the --auto-tag and --yes flags. It fails when suggestions would need
+// confirming but stdin is piped, since the answer could not be read from it.
+func autoTagFlags(cmd *cobra.Command) (autoTag bool, yes bool, err error) {
+	autoTag, _ = cmd.Flags().GetBool("auto-tag")
+	yes, _ = cmd.Flags().GetBool("yes")
+	if autoTag && !yes && !osutils.StdinIsTerminal() {
+		return false, false, fmt.Errorf("--auto-tag cannot ask for confirmation when stdin is piped. Pass --yes to apply the suggestions")
+	}
+	return autoTag, yes, nil
+}
+
+// autoTagNote asks the configured LLM for tag and title suggestions for note and applies the ones
+// the user accepts, or all of them when yes is set. noteList provides the existing tag vocabulary.
+// It reports whether note was changed.
+func autoTagNote(cmd *cobra.Command, note *notes.Note, noteList []notes.Note, yes bool) (bool, error) {
+	provider, err := newProvider()
+	if err != nil {
+		return false, err
+	}
+
+	tagMap := tags.TagMap{}
+	tagMap.BuildTagMap(noteList)
+	vocabulary := tagMap.GetAllTags("")
+	slices.Sort(vocabulary)
+
+	suggestion, err := ai.SuggestTags(cmd.Context(), provider, ai.DocumentsFromNotes([]notes.Note{*note})[0], vocabulary)
+	if err != nil {
+		return false, fmt.Errorf("failed to suggest tags: %w", err)
+	}
+	if len(suggestion.Tags) == 0 && suggestion.Title == "" {
+		cmd.Println("No new tags or title suggested.")
+		return false, nil
+	}
+
+	changed := false
+	if len(suggestion.Tags) > 0 {
+		labels := make([]string, len(suggestion.Tags))
+		for i, tag := range suggestion.Tags {
+			labels[i] = tag
+			if !slices.Contains(vocabulary, tag) {
+				labels[i] = tag + " (new)"
+			}
+		}
+		cmd.Printf("Suggested tags: %s\n", strings.Join(labels, ", "))
+
+		apply := yes
+		if !yes {
+			apply, err = osutils.Confirm(cmd.InOrStdin(), cmd.OutOrStdout(), "Apply these tags?")
+			if err != nil {
+				return false, fmt.Errorf("cannot read confirmation: %w", err)
+			}
+		}
+		if apply {
+			for _, tag := range suggestion.Tags {
+				note.AddTag(tagNormalizer().Normalize(tag))
+			}
+			changed = true
+		}
+	}
+
+	if suggestion.Title != "" {
+		cmd.Printf("Suggested title: %s\n", suggestion.Title)
+
+		apply := yes
+		if !yes {
+			apply, err = osutils.Confirm(cmd.InOrStdin(), cmd.OutOrStdout(), "Rename the note?")
+			if err != nil {
+				return false, fmt.Errorf("cannot read confirmation: %w", err)
+			}
+		}
+		if apply {
+			note.Title = suggestion.Title
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+func init() {
+	rootCmd.AddCommand(suggestTagsCmd)
+
+	suggestTagsCmd.Flags().BoolP("yes", "y", false, "Apply the suggestions without asking for confirmation")
+}
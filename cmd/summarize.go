@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+// summarizeCmd represents the summarize command
+var summarizeCmd = &cobra.Command{
+	Use:   "summarize <note>",
+	Short: "Summarize a note using the configured LLM",
+	Long: `Summarize a single note as Markdown bullet points using the configured LLM provider.
+
+Examples:
+  simple-jot summarize <note>
+`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeNoteArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteRef := args[0]
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+		idx, err := resolveNote(noteList, noteRef)
+		if err != nil {
+			return err
+		}
+
+		provider, err := newProvider()
+		if err != nil {
+			return err
+		}
+		summary, err := ai.Summarize(cmd.Context(), provider, ai.DocumentsFromNotes(noteList[idx:idx+1]), "", config.GetConfig().AIMaxTokens)
+		if err != nil {
+			return fmt.Errorf("failed to summarize note: %w", err)
+		}
+
+		cmd.Printf("# %s\n\n%s\n", noteList[idx].Title, summary)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(summarizeCmd)
+}
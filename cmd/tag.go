@@ -4,39 +4,55 @@ Copyright © 2025 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
 	storage "github.com/landanqrew/simple-jot/internal/storage"
 	tags "github.com/landanqrew/simple-jot/internal/tags"
+	"github.com/landanqrew/simple-jot/tabler"
 	tablewriter "github.com/olekukonko/tablewriter"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // tagCmd represents the tag command
 var tagCmd = &cobra.Command{
 	Use:   "tag",
-	Short: "view tags",
-	Long: `utility to view tags:
+	Short: "add, remove, rename and view tags",
+	Long: `utility to add, remove, rename and view tags:
 
 Usage:
-simple-jot tag list <optional-prefix>`,
+simple-jot tag add [note] <tag...>
+simple-jot tag remove [note] <tag...>
+simple-jot tag list <optional-prefix>
+simple-jot tag list --tree <optional-tag>
+simple-jot tag rename <old> <new>
+simple-jot tag merge <tag...> --into <tag>
+simple-jot tag alias <alias> <canonical>
+
+Without a note, tags are added to or removed from the active note.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cmd.Help()
 	},
 }
 
-var tagListCmd = &cobra.Command {
-	Use: "list",
+var tagListCmd = &cobra.Command{
+	Use:   "list",
 	Short: "list tags (optionally filter by prefix)",
 	Long: `list tags (optionally filter by prefix)
 
 Usage:
-simple-jot tag list <optional-prefix>`,
+simple-jot tag list <optional-prefix>
+simple-jot tag list --tree <optional-tag>`,
+	ValidArgsFunction: completeTagArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		prefix := ""
 		if len(args) > 0 {
@@ -46,6 +62,14 @@ simple-jot tag list <optional-prefix>`,
 		if err != nil {
 			log.Fatalln("Failed to get notes. Exiting Program")
 		}
+		if tree, _ := cmd.Flags().GetBool("tree"); tree {
+			tagMap := tags.TagMap{}
+			tagMap.BuildNormalizedTagMap(notes, tagNormalizer())
+			if err := printTagTree(cmd, tagMap, prefix); err != nil {
+				log.Fatalln(err)
+			}
+			return
+		}
 		tagMap := tags.TagMap{}
 		tagMap.BuildTagMap(notes)
 		dataFrame := make([][]string, 0)
@@ -70,7 +94,7 @@ simple-jot tag list <optional-prefix>`,
 			fmt.Println("No tags found matching your query (prefix: ", prefix, ")")
 			return
 		}
-		
+
 		table := tablewriter.NewWriter(os.Stdout)
 		table.Header(dataFrame[0])
 		table.Bulk(dataFrame[1:])
@@ -81,9 +105,309 @@ simple-jot tag list <optional-prefix>`,
 	},
 }
 
+var tagAddCmd = &cobra.Command{
+	Use:   "add [note] <tag...>",
+	Short: "add tags to a note (defaults to the active note)",
+	Long: `add one or more tags to a note. Without a note, the tags are added to the active note.
+
+Several tags are only added to the active note without naming it when they are all tags in use
+already, so that a mistyped note is reported rather than added as a tag; name it with @ otherwise.
+
+Usage:
+simple-jot tag add <note> <tag...>
+simple-jot tag add @ <tag...>
+simple-jot tag add <tag...>`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeNoteOrTagArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return updateNoteTags(cmd, args, func(n *notes.Note, tag string) { n.AddTag(tag) })
+	},
+}
+
+var tagRemoveCmd = &cobra.Command{
+	Use:   "remove [note] <tag...>",
+	Short: "remove tags from a note (defaults to the active note)",
+	Long: `remove one or more tags from a note. Without a note, the tags are removed from the active note.
+
+Usage:
+simple-jot tag remove <note> <tag...>
+simple-jot tag remove <tag...>`,
+	Aliases:           []string{"rm"},
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeNoteOrTagArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		normalizer := tagNormalizer()
+		return updateNoteTags(cmd, args, func(n *notes.Note, tag string) {
+			// also remove tags written before an alias or case folding was configured
+			for _, existing := range slices.Clone(n.Tags) {
+				if normalizer.Normalize(existing) == tag {
+					n.RemoveTag(existing)
+				}
+			}
+		})
+	},
+}
+
+// updateNoteTags applies update to the note and tags given in args and saves the result.
+func updateNoteTags(cmd *cobra.Command, args []string, update func(n *notes.Note, tag string)) error {
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		return fmt.Errorf("cannot fetch notes: %v", err)
+	}
+
+	idx, tagArgs, err := splitNoteAndTags(noteList, args)
+	if err != nil {
+		return err
+	}
+	if len(tagArgs) == 0 {
+		return fmt.Errorf("no tags given")
+	}
+	for _, tag := range tagArgs {
+		update(&noteList[idx], tag)
+	}
+
+	if err := storage.SaveNotes(noteList); err != nil {
+		return fmt.Errorf("cannot save notes: %v", err)
+	}
+	currentNote := noteList[idx]
+	cmd.Printf("Tags on %s: %s\n", currentNote.ID, strings.Join(currentNote.Tags, ", "))
+
+	return tabler.RenderTable([][]string{currentNote.PrepRow()}, currentNote.GetHeaders())
+}
+
+// splitNoteAndTags returns the index of the note named by args and the tags that follow it. The
+// first argument is the note when it resolves to one and more arguments follow. A single argument
+// is a tag for the active note, and so are several when the first is not a note but every one of
+// them is a tag already in use; otherwise the first argument is taken to be a mistyped note, and
+// the error points at ActiveNoteRef when an active note is set.
+func splitNoteAndTags(noteList []notes.Note, args []string) (int, []string, error) {
+	if len(args) > 1 {
+		idx, err := resolveNote(noteList, args[0])
+		var ambiguous *notes.AmbiguousRefError
+		if errors.As(err, &ambiguous) {
+			return -1, nil, err
+		}
+		if err == nil {
+			return idx, normalizeTags(args[1:]), nil
+		}
+		if config.GetConfig().ActiveNote == "" {
+			return -1, nil, err
+		}
+		if !allTagsInUse(noteList, args) {
+			return -1, nil, fmt.Errorf("%w. To tag the active note, pass '%s' first, as in '%s %s'",
+				err, notes.ActiveNoteRef, notes.ActiveNoteRef, strings.Join(args, " "))
+		}
+	}
+
+	idx, err := resolveNote(noteList, notes.ActiveNoteRef)
+	if err != nil {
+		return -1, nil, err
+	}
+	return idx, normalizeTags(args), nil
+}
+
+// allTagsInUse reports whether every tag in tagArgs is already on some note.
+func allTagsInUse(noteList []notes.Note, tagArgs []string) bool {
+	normalizer := tagNormalizer()
+	inUse := make(map[string]bool)
+	for _, n := range noteList {
+		for _, tag := range n.Tags {
+			inUse[normalizer.Normalize(tag)] = true
+		}
+	}
+	for _, tag := range normalizeTags(tagArgs) {
+		if !inUse[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// printTagTree prints the tag hierarchy with the number of notes under each tag, limited to the
+// subtree of root when it is given.
+func printTagTree(cmd *cobra.Command, tagMap tags.TagMap, root string) error {
+	node := tagMap.Tree()
+	if root = tagNormalizer().Normalize(root); root != "" {
+		if node = node.Find(root); node == nil {
+			return fmt.Errorf("no tags found under (%s)", root)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s (%d)\n", node.Path, len(node.SubtreeNoteIDs()))
+	} else if len(node.Children) == 0 {
+		cmd.Println("No tags found.")
+		return nil
+	}
+	fmt.Fprint(cmd.OutOrStdout(), node.Format())
+	return nil
+}
+
+// tagNormalizer returns the normalizer for the configured tag aliases and case folding.
+func tagNormalizer() tags.Normalizer {
+	cfg := config.GetConfig()
+	return tags.Normalizer{Aliases: cfg.TagAliases, FoldCase: cfg.TagCaseFold}
+}
+
+// normalizeTags maps the tags onto their canonical form and drops empty and repeated ones.
+func normalizeTags(tagArgs []string) []string {
+	normalizer := tagNormalizer()
+	normalized := make([]string, 0, len(tagArgs))
+	for _, tag := range tagArgs {
+		if tag = normalizer.Normalize(tag); tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+var tagRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "rename a tag on every note",
+	Long: `rename a tag on every note that has it. Notes that already have the new tag keep a single copy.
+
+Usage:
+simple-jot tag rename k8s kubernetes`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeTagArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rewriteTags(cmd, args[:1], args[1])
+	},
+}
+
+var tagMergeCmd = &cobra.Command{
+	Use:   "merge <tag...> --into <tag>",
+	Short: "merge tags into one tag on every note",
+	Long: `replace each of the given tags with the --into tag on every note, in a single save.
+
+Usage:
+simple-jot tag merge k8s kube --into kubernetes`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeTagArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		into, _ := cmd.Flags().GetString("into")
+		return rewriteTags(cmd, args, into)
+	},
+}
+
+// rewriteTags replaces the from tags with to across the whole store and saves it once. Tags are
+// compared, and to is written, in their normalized form, so case folding and aliases apply.
+func rewriteTags(cmd *cobra.Command, from []string, to string) error {
+	normalizer := tagNormalizer()
+	to = normalizer.Normalize(to)
+	if to == "" {
+		return fmt.Errorf("the new tag cannot be empty")
+	}
+
+	noteList, err := storage.GetNotes()
+	if err != nil {
+		return fmt.Errorf("cannot fetch notes: %v", err)
+	}
+
+	changed := normalizer.Rewrite(noteList, from, to)
+	if changed == 0 {
+		return fmt.Errorf("no notes found for tag (%s)", strings.Join(from, ", "))
+	}
+	if err := storage.SaveNotes(noteList); err != nil {
+		return fmt.Errorf("cannot save notes: %v", err)
+	}
+
+	cmd.Printf("Retagged %d note(s): %s -> %s\n", changed, strings.Join(from, ", "), to)
+	return nil
+}
+
+var tagAliasCmd = &cobra.Command{
+	Use:   "alias [<alias> <canonical>]",
+	Short: "make a tag an alias of another tag",
+	Long: `make a tag an alias of a canonical tag. Searches for the alias and new taggings with it use the
+canonical tag instead. Existing notes are not changed; use 'simple-jot tag rename' for that.
+
+Usage:
+simple-jot tag alias k8s kubernetes
+simple-jot tag alias              (list aliases)
+simple-jot tag alias --remove k8s`,
+	Args: cobra.RangeArgs(0, 2),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return completeTags(toComplete)
+		}
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg := config.GetConfig()
+		remove, _ := cmd.Flags().GetBool("remove")
+
+		switch {
+		case remove:
+			if len(args) != 1 {
+				return fmt.Errorf("--remove takes exactly one alias")
+			}
+			alias := strings.ToLower(strings.TrimSpace(args[0]))
+			if _, ok := cfg.TagAliases[alias]; !ok {
+				return fmt.Errorf("tag alias '%s' not found", args[0])
+			}
+			delete(cfg.TagAliases, alias)
+			if err := writeTagAliases(cfg.TagAliases); err != nil {
+				return err
+			}
+			cmd.Printf("Tag alias '%s' removed.\n", args[0])
+			return nil
+
+		case len(args) == 0:
+			if len(cfg.TagAliases) == 0 {
+				cmd.Println("No tag aliases defined. Define one using 'simple-jot tag alias <alias> <canonical>'.")
+				return nil
+			}
+			dataFrame := make([][]string, 0, len(cfg.TagAliases))
+			for alias, canonical := range cfg.TagAliases {
+				dataFrame = append(dataFrame, []string{alias, canonical})
+			}
+			slices.SortFunc(dataFrame, func(a, b []string) int { return strings.Compare(a[0], b[0]) })
+			return tabler.RenderTable(dataFrame, []string{"Alias", "Tag"})
+
+		case len(args) == 2:
+			// aliases are matched case-insensitively, and the config file keeps keys lower-case anyway
+			alias := strings.ToLower(strings.TrimSpace(args[0]))
+			canonical := tagNormalizer().Normalize(args[1])
+			if alias == "" || canonical == "" {
+				return fmt.Errorf("the alias and tag cannot be empty")
+			}
+			if strings.EqualFold(alias, canonical) {
+				return fmt.Errorf("a tag cannot be an alias of itself")
+			}
+			if cfg.TagAliases == nil {
+				cfg.TagAliases = make(map[string]string)
+			}
+			cfg.TagAliases[alias] = canonical
+			if err := writeTagAliases(cfg.TagAliases); err != nil {
+				return err
+			}
+			cmd.Printf("Tag '%s' is now an alias of '%s'.\n", alias, canonical)
+			return nil
+
+		default:
+			return fmt.Errorf("give both an alias and the tag it stands for")
+		}
+	},
+}
+
+// writeTagAliases replaces the tag_aliases section of the config file.
+func writeTagAliases(aliases map[string]string) error {
+	viper.Set("tag_aliases", aliases)
+	return saveConfig()
+}
+
 func init() {
 	rootCmd.AddCommand(tagCmd)
 	tagCmd.AddCommand(tagListCmd)
+	tagCmd.AddCommand(tagAddCmd)
+	tagCmd.AddCommand(tagRemoveCmd)
+	tagCmd.AddCommand(tagRenameCmd)
+	tagCmd.AddCommand(tagMergeCmd)
+	tagCmd.AddCommand(tagAliasCmd)
+
+	tagListCmd.Flags().Bool("tree", false, "Show tags as a hierarchy split on '/', with the number of notes under each tag")
+	tagMergeCmd.Flags().String("into", "", "Tag that replaces the merged tags")
+	tagMergeCmd.MarkFlagRequired("into")
+	cobra.CheckErr(tagMergeCmd.RegisterFlagCompletionFunc("into", completeTagArgs))
+	tagAliasCmd.Flags().Bool("remove", false, "Remove the alias instead of defining it")
 
 	// Here you will define your flags and configuration settings.
 
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestTagAddRemoveCmd(t *testing.T) {
+	tests := []struct {
+		name          string
+		command       *cobra.Command
+		args          []string
+		activeNote    string
+		expectedTags  map[string][]string
+		expectedError string
+	}{
+		{
+			name:         "Add tags to a named note",
+			command:      tagAddCmd,
+			args:         []string{"Second Note", "work", "urgent"},
+			expectedTags: map[string][]string{"note1": {"old"}, "note2": {"work", "urgent"}},
+		},
+		{
+			name:         "Add tags to the active note",
+			command:      tagAddCmd,
+			args:         []string{"@", "work", "urgent"},
+			activeNote:   "note1",
+			expectedTags: map[string][]string{"note1": {"old", "work", "urgent"}, "note2": {}},
+		},
+		{
+			name:         "Add an existing tag",
+			command:      tagAddCmd,
+			args:         []string{"note1", "old"},
+			expectedTags: map[string][]string{"note1": {"old"}, "note2": {}},
+		},
+		{
+			name:         "Remove a tag from the active note",
+			command:      tagRemoveCmd,
+			args:         []string{"old"},
+			activeNote:   "note1",
+			expectedTags: map[string][]string{"note1": {}, "note2": {}},
+		},
+		{
+			name:          "Unknown note without an active note",
+			command:       tagAddCmd,
+			args:          []string{"missing", "work"},
+			expectedError: "no note matches 'missing'",
+		},
+		{
+			name:         "Add tags in use to the active note",
+			command:      tagAddCmd,
+			args:         []string{"old", "old"},
+			activeNote:   "note2",
+			expectedTags: map[string][]string{"note1": {"old"}, "note2": {"old"}},
+		},
+		{
+			name:          "Mistyped note with an active note",
+			command:       tagAddCmd,
+			args:          []string{"Secnd Note", "urgent"},
+			activeNote:    "note1",
+			expectedError: "no note matches 'Secnd Note'",
+		},
+		{
+			name:          "New tags with an active note",
+			command:       tagAddCmd,
+			args:          []string{"newtag1", "newtag2"},
+			activeNote:    "note1",
+			expectedError: "To tag the active note, pass '@' first, as in '@ newtag1 newtag2'",
+		},
+		{
+			name:          "Single tag without an active note",
+			command:       tagAddCmd,
+			args:          []string{"work"},
+			expectedError: "no active note is set",
+		},
+		{
+			name:          "Only blank tags",
+			command:       tagAddCmd,
+			args:          []string{"note1", " "},
+			expectedError: "no tags given",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockStorage{notes: []notes.Note{
+				{ID: "note1", Title: "First Note", Tags: []string{"old"}},
+				{ID: "note2", Title: "Second Note", Tags: []string{}},
+			}}
+			storage.SetDefaultStorage(mock)
+			t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+			viper.Set("active_note", tt.activeNote)
+			t.Cleanup(func() { viper.Set("active_note", "") })
+			if err := config.InitConfig(); err != nil {
+				t.Fatalf("InitConfig returned an error: %v", err)
+			}
+
+			cmd := cobra.Command{Use: tt.command.Use, Args: tt.command.Args, RunE: tt.command.RunE}
+			cmd.SetOut(new(bytes.Buffer))
+			cmd.SetErr(new(bytes.Buffer))
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			for _, n := range mock.notes {
+				if !slices.Equal(n.Tags, tt.expectedTags[n.ID]) {
+					t.Errorf("Expected tags %v on %s, got %v", tt.expectedTags[n.ID], n.ID, n.Tags)
+				}
+			}
+		})
+	}
+}
+
+func TestTagMergeCmd(t *testing.T) {
+	mock := &mockStorage{notes: []notes.Note{
+		{ID: "note1", Tags: []string{"k8s", "ops", "kubernetes"}},
+		{ID: "note2", Tags: []string{"kube"}},
+		{ID: "note3", Tags: []string{"ops"}},
+	}}
+	storage.SetDefaultStorage(mock)
+
+	cmd := cobra.Command{Use: "merge", Args: tagMergeCmd.Args, RunE: tagMergeCmd.RunE}
+	cmd.Flags().String("into", "", "")
+	output := new(bytes.Buffer)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	cmd.SetArgs([]string{"k8s", "kube", "--into", "kubernetes"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Did not expect an error but got: %v", err)
+	}
+	if !strings.Contains(output.String(), "Retagged 2 note(s)") {
+		t.Errorf("Expected output to report 2 retagged notes, got %q", output.String())
+	}
+	expectedTags := [][]string{{"kubernetes", "ops"}, {"kubernetes"}, {"ops"}}
+	for i, n := range mock.notes {
+		if !slices.Equal(n.Tags, expectedTags[i]) {
+			t.Errorf("Expected tags %v on %s, got %v", expectedTags[i], n.ID, n.Tags)
+		}
+	}
+}
+
+func TestTagAddNormalizesTags(t *testing.T) {
+	mock := &mockStorage{notes: []notes.Note{{ID: "note1", Title: "First Note", Tags: []string{}}}}
+	storage.SetDefaultStorage(mock)
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	viper.Set("tag_aliases", map[string]string{"k8s": "kubernetes"})
+	viper.Set("tag_case_fold", true)
+	t.Cleanup(func() {
+		viper.Set("tag_aliases", map[string]string{})
+		viper.Set("tag_case_fold", false)
+	})
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	cmd := cobra.Command{Use: "add", Args: tagAddCmd.Args, RunE: tagAddCmd.RunE}
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetArgs([]string{"note1", "K8s", "TODO", "todo"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Did not expect an error but got: %v", err)
+	}
+	if !slices.Equal(mock.notes[0].Tags, []string{"kubernetes", "todo"}) {
+		t.Errorf("Expected normalized tags [kubernetes todo], got %v", mock.notes[0].Tags)
+	}
+}
+
+func TestTagRenameNormalizesTags(t *testing.T) {
+	mock := &mockStorage{notes: []notes.Note{
+		{ID: "note1", Tags: []string{"k8s", "ops"}},
+		{ID: "note2", Tags: []string{"Ops"}},
+	}}
+	storage.SetDefaultStorage(mock)
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	viper.Set("tag_aliases", map[string]string{"infra": "operations"})
+	viper.Set("tag_case_fold", true)
+	t.Cleanup(func() {
+		viper.Set("tag_aliases", map[string]string{})
+		viper.Set("tag_case_fold", false)
+	})
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	run := func(args ...string) {
+		cmd := cobra.Command{Use: "rename", Args: tagRenameCmd.Args, RunE: tagRenameCmd.RunE}
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("rename %v returned an error: %v", args, err)
+		}
+	}
+
+	run("K8S", "kubernetes")
+	// renaming into an alias stores the tag it stands for
+	run("OPS", "infra")
+
+	expectedTags := [][]string{{"kubernetes", "operations"}, {"operations"}}
+	for i, n := range mock.notes {
+		if !slices.Equal(n.Tags, expectedTags[i]) {
+			t.Errorf("Expected tags %v on %s, got %v", expectedTags[i], n.ID, n.Tags)
+		}
+	}
+}
+
+func TestTagListTreeNormalizesTags(t *testing.T) {
+	storage.SetDefaultStorage(&mockStorage{notes: []notes.Note{
+		{ID: "note1", Tags: []string{"Project/API"}},
+		{ID: "note2", Tags: []string{"project/api", "project/Web"}},
+	}})
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	viper.Set("tag_case_fold", true)
+	t.Cleanup(func() { viper.Set("tag_case_fold", false) })
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "Whole tree", expected: "project (2)\n├── api (2)\n└── web (1)\n"},
+		{name: "Mixed case root", args: []string{"Project"}, expected: "project (2)\n├── api (2)\n└── web (1)\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := cobra.Command{Use: "list", Run: tagListCmd.Run}
+			cmd.Flags().Bool("tree", true, "")
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			cmd.SetArgs(tt.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if out.String() != tt.expected {
+				t.Errorf("Expected output\n%s\ngot\n%s", tt.expected, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/landanqrew/simple-jot/internal/tasks"
+	"github.com/landanqrew/simple-jot/tabler"
+	"github.com/spf13/cobra"
+)
+
+// tasksCmd represents the tasks command
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "List the checkbox tasks in your notes",
+	Long: `List the Markdown task items ("- [ ] ..." and "- [x] ...") found in your notes, with the
+note and line they come from. Use the Ref column to tick a task off with 'simple-jot tasks done'.
+
+Examples:
+  simple-jot tasks
+  simple-jot tasks --open --tag project/api
+  simple-jot tasks done 3f2a9c41:12
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+
+		candidates := noteList
+		if tag, _ := cmd.Flags().GetString("tag"); tag != "" {
+			candidates = filterNotes(cmd, noteList, config.SearchQuery{Tag: tag})
+		}
+		openOnly, _ := cmd.Flags().GetBool("open")
+
+		prefixLength := uniquePrefixLength(noteList)
+		dataFrame := make([][]string, 0)
+		for _, task := range tasks.Extract(candidates) {
+			if openOnly && task.Done {
+				continue
+			}
+			status := "[ ]"
+			if task.Done {
+				status = "[x]"
+			}
+			ref := shortTaskRef(task, prefixLength)
+			dataFrame = append(dataFrame, []string{ref, status, task.Text, task.NoteTitle})
+		}
+
+		if len(dataFrame) == 0 {
+			cmd.Println("No tasks found.")
+			return nil
+		}
+		return tabler.RenderTable(dataFrame, []string{"Ref", "Done", "Task", "Note"})
+	},
+}
+
+// tasksDoneCmd represents the done subcommand of tasks
+var tasksDoneCmd = &cobra.Command{
+	Use:   "done <task-ref...>",
+	Short: "Tick off tasks in their notes",
+	Long: `Tick the checkbox of each task in place in its note. A task reference is <note>:<line> as
+shown in the Ref column of 'simple-jot tasks'; the note part may be any note reference.
+
+Examples:
+  simple-jot tasks done 3f2a9c41:12
+  simple-jot tasks done @:4 @:5
+  simple-jot tasks done 3f2a9c41:12 --undo
+`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeTaskRefs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		undo, _ := cmd.Flags().GetBool("undo")
+
+		noteList, err := storage.GetNotes()
+		if err != nil {
+			return fmt.Errorf("cannot fetch notes: %w", err)
+		}
+
+		changed := make(map[int]struct{})
+		for _, ref := range args {
+			noteRef, line, err := tasks.ParseRef(ref)
+			if err != nil {
+				return err
+			}
+			idx, err := resolveNote(noteList, noteRef)
+			if err != nil {
+				return err
+			}
+			note := &noteList[idx]
+
+			content, err := tasks.SetDone(note.Content, line, !undo)
+			if err != nil {
+				return fmt.Errorf("task '%s': %w", ref, err)
+			}
+			if content == note.Content {
+				cmd.Printf("Task %s is already %s.\n", ref, taskState(!undo))
+				continue
+			}
+			note.Content = content
+			note.UpdatedAt = time.Now().Format(time.DateTime)
+			changed[idx] = struct{}{}
+			cmd.Printf("Marked task %s as %s.\n", ref, taskState(!undo))
+		}
+
+		if len(changed) == 0 {
+			return nil
+		}
+		if err := storage.SaveNotes(noteList); err != nil {
+			return fmt.Errorf("cannot save notes: %w", err)
+		}
+		updated := make([]notes.Note, 0, len(changed))
+		for idx := range changed {
+			updated = append(updated, noteList[idx])
+		}
+		updateVectorIndex(cmd, updated)
+		return nil
+	},
+}
+
+// shortTaskRef returns the task reference with the note ID cut to prefixLength characters.
+func shortTaskRef(task tasks.Task, prefixLength int) string {
+	return task.NoteID[:min(prefixLength, len(task.NoteID))] + ":" + strconv.Itoa(task.Line)
+}
+
+// taskState describes a task's checkbox for messages.
+func taskState(done bool) string {
+	if done {
+		return "done"
+	}
+	return "open"
+}
+
+func init() {
+	rootCmd.AddCommand(tasksCmd)
+	tasksCmd.AddCommand(tasksDoneCmd)
+
+	tasksCmd.Flags().Bool("open", false, "Only list tasks that are not done")
+	tasksCmd.Flags().StringP("tag", "t", "", "Only list tasks from notes with this tag (including the tags below it)")
+	registerTagFlagCompletion(tasksCmd)
+	tasksDoneCmd.Flags().Bool("undo", false, "Clear the checkbox instead of ticking it")
+}
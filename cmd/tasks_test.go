@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestTasksDoneCmd(t *testing.T) {
+	t.Setenv("SIMPLE_JOT_DATA_DIR", t.TempDir())
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		args            []string
+		expectedContent string
+		expectedOutput  string
+		expectedError   string
+	}{
+		{
+			name:            "Tick a task",
+			args:            []string{"note1:2"},
+			expectedContent: "# Release\n- [x] write changelog\n- [x] bump version",
+			expectedOutput:  "Marked task note1:2 as done.",
+		},
+		{
+			name:            "Tick a task by note title",
+			args:            []string{"Release:2"},
+			expectedContent: "# Release\n- [x] write changelog\n- [x] bump version",
+		},
+		{
+			name:            "Reopen a task",
+			args:            []string{"note1:3", "--undo"},
+			expectedContent: "# Release\n- [ ] write changelog\n- [ ] bump version",
+		},
+		{
+			name:            "Already done",
+			args:            []string{"note1:3"},
+			expectedContent: "# Release\n- [ ] write changelog\n- [x] bump version",
+			expectedOutput:  "Task note1:3 is already done.",
+		},
+		{
+			name:          "Not a task",
+			args:          []string{"note1:1"},
+			expectedError: "line 1 is not a task",
+		},
+		{
+			name:          "Invalid reference",
+			args:          []string{"note1"},
+			expectedError: "invalid task reference",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockStorage{notes: []notes.Note{
+				{ID: "note1", Title: "Release", Content: "# Release\n- [ ] write changelog\n- [x] bump version", UpdatedAt: "2025-01-01 00:00:00"},
+			}}
+			storage.SetDefaultStorage(mock)
+
+			cmd := cobra.Command{Use: "done", Args: tasksDoneCmd.Args, RunE: tasksDoneCmd.RunE}
+			cmd.Flags().Bool("undo", false, "")
+			output := new(bytes.Buffer)
+			cmd.SetOut(output)
+			cmd.SetErr(output)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if got := mock.notes[0].Content; got != tt.expectedContent {
+				t.Errorf("Expected content %q, got %q", tt.expectedContent, got)
+			}
+			if !strings.Contains(output.String(), tt.expectedOutput) {
+				t.Errorf("Expected output to contain %q, got %q", tt.expectedOutput, output.String())
+			}
+			changed := tt.expectedContent != "# Release\n- [ ] write changelog\n- [x] bump version"
+			if (mock.notes[0].UpdatedAt != "2025-01-01 00:00:00") != changed {
+				t.Errorf("Expected UpdatedAt to change only with the content, got %s", mock.notes[0].UpdatedAt)
+			}
+		})
+	}
+}
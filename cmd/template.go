@@ -0,0 +1,212 @@
+/*
+Copyright © 2025 NAME HERE <EMAIL ADDRESS>
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/osutils"
+	"github.com/landanqrew/simple-jot/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage note templates",
+	Long: `Manage the note templates used by 'simple-jot create <title> --template <name>'.
+
+Templates are Markdown files in the templates directory under data_dir, rendered with Go's
+text/template. They can use {{.Title}}, {{.Date}}, {{.Time}}, {{.Now}}, {{.ActiveNote}} and
+{{.ActiveNoteID}}, and {{prompt "name"}} to ask for a value when the note is created (or take it
+from --var name=value). Tags, a due date and properties in the template's front matter are added
+to the new note, and a title there replaces the one given to create.
+
+Usage:
+  simple-jot template list
+  simple-jot template show <name>
+  simple-jot template new <name>
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+// templateListCmd represents the template list subcommand
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the available templates",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := templatesDir()
+		names, err := templates.List(dir)
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			cmd.Printf("No templates in %s. Create one with 'simple-jot template new <name>'.\n", dir)
+			return nil
+		}
+		for _, name := range names {
+			fmt.Fprintln(cmd.OutOrStdout(), name)
+		}
+		return nil
+	},
+}
+
+// templateShowCmd represents the template show subcommand
+var templateShowCmd = &cobra.Command{
+	Use:               "show <name>",
+	Short:             "Print a template",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeTemplateArg,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		text, err := templates.Load(templatesDir(), args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), text)
+		return nil
+	},
+}
+
+// templateNewCmd represents the template new subcommand
+var templateNewCmd = &cobra.Command{
+	Use:   "new <name>",
+	Short: "Create a template",
+	Long: `Create a template from content piped to stdin, or from a starter template that can be
+written in the configured editor with --editor.
+
+Examples:
+  simple-jot template new meeting --editor
+  cat adr.md | simple-jot template new adr
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		if err := templates.ValidateName(name); err != nil {
+			return err
+		}
+		dir := templatesDir()
+		path := templates.Path(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("template '%s' already exists at %s", name, path)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("cannot check template '%s': %w", name, err)
+		}
+
+		useEditor, _ := cmd.Flags().GetBool("editor")
+		text, err := osutils.ReadStdin()
+		if err != nil {
+			return fmt.Errorf("error reading from stdin: %v", err)
+		}
+		if text != "" && useEditor {
+			return fmt.Errorf("cannot use --editor with content piped to stdin")
+		}
+		if text == "" {
+			text = templates.Skeleton
+		}
+		if useEditor {
+			editor := config.GetConfig().Editor
+			if editor == "" {
+				return fmt.Errorf("no editor configured. Please set one using 'simple-jot config set editor <editor>' or the EDITOR environment variable")
+			}
+			if text, err = osutils.EditText(editor, text, "simple-jot-template-*.md"); err != nil {
+				return err
+			}
+		}
+
+		// check the template before saving it, so mistakes show up now rather than on create
+		if _, err := templates.Render(name, text, templates.Data{}, func(string) (string, error) { return "", nil }); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("cannot create templates directory: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(text), 0644); err != nil {
+			return fmt.Errorf("cannot save template '%s': %w", name, err)
+		}
+		cmd.Printf("Template '%s' saved to %s\n", name, path)
+		return nil
+	},
+}
+
+// templatesDir returns the templates directory under the configured data directory.
+func templatesDir() string {
+	return templates.Dir(config.GetConfig().DataDir)
+}
+
+// renderTemplate renders the template called name for a new note titled title. Prompted values
+// are taken from vars ("name=value") or else read from the command's input, unless stdinUsed
+// says the input was already read as note content.
+func renderTemplate(cmd *cobra.Command, name string, title string, noteList []notes.Note, vars []string, stdinUsed bool) (notes.FrontMatter, error) {
+	text, err := templates.Load(templatesDir(), name)
+	if err != nil {
+		return notes.FrontMatter{}, err
+	}
+
+	values := make(map[string]string, len(vars))
+	for _, v := range vars {
+		key, value, found := strings.Cut(v, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return notes.FrontMatter{}, fmt.Errorf("invalid --var '%s', expected name=value", v)
+		}
+		values[strings.TrimSpace(key)] = value
+	}
+
+	var activeNote *notes.Note
+	activeID := config.GetConfig().ActiveNote
+	if idx := slices.IndexFunc(noteList, func(n notes.Note) bool { return n.ID == activeID }); activeID != "" && idx != -1 {
+		activeNote = &noteList[idx]
+	}
+
+	prompt := func(name string) (string, error) {
+		if value, ok := values[name]; ok {
+			return value, nil
+		}
+		if stdinUsed {
+			return "", fmt.Errorf("no value for '%s' while note content is piped to stdin; pass --var '%s=<value>'", name, name)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: ", name)
+		value, err := osutils.ReadLine(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("cannot read %s: %w", name, err)
+		}
+		return strings.TrimSpace(value), nil
+	}
+	return templates.Render(name, text, templates.NewData(title, time.Now(), activeNote), prompt)
+}
+
+// completeTemplateArg completes the first argument with template names.
+func completeTemplateArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeTemplates(cmd, args, toComplete)
+}
+
+// completeTemplates returns the names of the available templates.
+func completeTemplates(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names, err := templates.List(templatesDir())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateListCmd)
+	templateCmd.AddCommand(templateShowCmd)
+	templateCmd.AddCommand(templateNewCmd)
+
+	templateNewCmd.Flags().BoolP("editor", "e", false, "Write the template in the configured editor")
+}
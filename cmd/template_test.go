@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/config"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func TestCreateWithTemplate(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv("SIMPLE_JOT_DATA_DIR", dataDir)
+	if err := config.InitConfig(); err != nil {
+		t.Fatalf("InitConfig returned an error: %v", err)
+	}
+	templateDir := filepath.Join(dataDir, "templates")
+	if err := os.MkdirAll(templateDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	text := "---\ntags: [meeting]\n---\n# {{.Title}}\nAttendees: {{prompt \"attendees\"}}\nAgenda: {{prompt \"agenda\"}}\n"
+	if err := os.WriteFile(filepath.Join(templateDir, "meeting.md"), []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatalf("Failed to open /dev/null: %v", err)
+	}
+	defer devNull.Close()
+	oldStdin := os.Stdin
+	os.Stdin = devNull
+	defer func() { os.Stdin = oldStdin }()
+
+	mock := &mockStorage{notes: []notes.Note{}}
+	storage.SetDefaultStorage(mock)
+
+	cmd := cobra.Command{Use: createCmd.Use, Args: createCmd.Args, RunE: createCmd.RunE}
+	cmd.Flags().StringP("note", "n", "", "")
+	cmd.Flags().StringSliceP("tag", "t", nil, "")
+	cmd.Flags().String("template", "", "")
+	cmd.Flags().StringArray("var", nil, "")
+	output := new(bytes.Buffer)
+	cmd.SetOut(output)
+	cmd.SetErr(output)
+	// prompts not given with --var are read from the command's input
+	cmd.SetIn(strings.NewReader("plan Q3\n"))
+	cmd.SetArgs([]string{"Sync", "--template", "meeting", "--var", "attendees=sam, kim", "-t", "team", "-n", "extra"})
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Did not expect an error but got: %v", err)
+	}
+	if len(mock.notes) != 1 {
+		t.Fatalf("Expected one note, got %d", len(mock.notes))
+	}
+	note := mock.notes[0]
+	if expected := "# Sync\nAttendees: sam, kim\nAgenda: plan Q3\n\nextra"; note.Content != expected {
+		t.Errorf("Expected content %q, got %q", expected, note.Content)
+	}
+	if !slices.Equal(note.Tags, []string{"meeting", "team"}) {
+		t.Errorf("Expected tags [meeting team], got %v", note.Tags)
+	}
+	if !strings.Contains(output.String(), "agenda: ") {
+		t.Errorf("Expected a prompt for agenda, got %q", output.String())
+	}
+}
@@ -0,0 +1,74 @@
+package agenda
+
+import (
+	"slices"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/tasks"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+)
+
+// Item is something with a due date: a note, or an open task inside a note.
+type Item struct {
+	Due       timeutils.Due
+	Text      string // note title or task text
+	NoteID    string
+	NoteTitle string
+	Task      *tasks.Task // nil when the item is the note itself
+}
+
+// Agenda groups items by when they are due.
+type Agenda struct {
+	Overdue  []Item
+	Today    []Item
+	Upcoming []Item
+}
+
+// Empty reports whether nothing is on the agenda.
+func (a Agenda) Empty() bool {
+	return len(a.Overdue) == 0 && len(a.Today) == 0 && len(a.Upcoming) == 0
+}
+
+// Due returns the overdue items followed by the items due today.
+func (a Agenda) Due() []Item {
+	return append(slices.Clone(a.Overdue), a.Today...)
+}
+
+// Collect returns the notes with a due date and the open tasks with a due date, ordered by due
+// date. Notes with an unreadable due date are skipped.
+func Collect(noteList []notes.Note) []Item {
+	items := make([]Item, 0)
+	for _, n := range noteList {
+		if due, err := timeutils.ParseDueValue(n.Due); err == nil && !due.IsZero() {
+			items = append(items, Item{Due: due, Text: n.Title, NoteID: n.ID, NoteTitle: n.Title})
+		}
+	}
+	for _, task := range tasks.Extract(noteList) {
+		if task.Done || task.Due.IsZero() {
+			continue
+		}
+		items = append(items, Item{Due: task.Due, Text: task.Text, NoteID: task.NoteID, NoteTitle: task.NoteTitle, Task: &task})
+	}
+	slices.SortStableFunc(items, func(a, b Item) int { return a.Due.Time.Compare(b.Due.Time) })
+	return items
+}
+
+// Build sorts items into overdue at now, due today, and due within the next days days.
+func Build(items []Item, now time.Time, days int) Agenda {
+	var a Agenda
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	horizon := today.AddDate(0, 0, days)
+	for _, item := range items {
+		day := item.Due.Day()
+		switch {
+		case item.Due.Overdue(now):
+			a.Overdue = append(a.Overdue, item)
+		case day.Equal(today):
+			a.Today = append(a.Today, item)
+		case !day.After(horizon):
+			a.Upcoming = append(a.Upcoming, item)
+		}
+	}
+	return a
+}
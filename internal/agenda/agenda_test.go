@@ -0,0 +1,60 @@
+package agenda
+
+import (
+	"testing"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestCollectAndBuild(t *testing.T) {
+	now := time.Date(2025, 7, 15, 12, 0, 0, 0, time.Local)
+	noteList := []notes.Note{
+		{ID: "n1", Title: "Quarterly report", Due: "2025-07-14"},
+		{ID: "n2", Title: "Release", Content: "- [ ] tag release due:2025-07-15\n- [x] write notes due:2025-07-10\n- [ ] announce due:2025-07-18\n- [ ] no date"},
+		{ID: "n3", Title: "Standup", Due: "2025-07-15 09:00"},
+		{ID: "n4", Title: "Offsite", Due: "2025-08-30"},
+		{ID: "n5", Title: "Lunch", Due: "2025-07-15 13:00"},
+		{ID: "n6", Title: "Broken", Due: "not a date"},
+	}
+
+	items := Collect(noteList)
+	var texts []string
+	for _, item := range items {
+		texts = append(texts, item.Text)
+	}
+	wantOrder := []string{"Quarterly report", "tag release", "Standup", "Lunch", "announce", "Offsite"}
+	if len(texts) != len(wantOrder) {
+		t.Fatalf("Expected items %v, got %v", wantOrder, texts)
+	}
+	for i := range wantOrder {
+		if texts[i] != wantOrder[i] {
+			t.Fatalf("Expected items %v, got %v", wantOrder, texts)
+		}
+	}
+	if items[1].Task == nil || items[1].Task.Line != 1 || items[0].Task != nil {
+		t.Errorf("Expected task items to carry their task and notes not to, got %+v and %+v", items[0], items[1])
+	}
+
+	a := Build(items, now, 7)
+	check := func(name string, got []Item, want ...string) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Errorf("%s: expected %v, got %d items", name, want, len(got))
+			return
+		}
+		for i := range want {
+			if got[i].Text != want[i] {
+				t.Errorf("%s: expected %v, got item %d = %q", name, want, i, got[i].Text)
+			}
+		}
+	}
+	check("overdue", a.Overdue, "Quarterly report", "Standup")
+	check("today", a.Today, "tag release", "Lunch")
+	check("upcoming", a.Upcoming, "announce")
+	check("due", a.Due(), "Quarterly report", "Standup", "tag release", "Lunch")
+
+	if Build(nil, now, 7).Empty() != true {
+		t.Error("Expected an agenda without items to be empty")
+	}
+}
@@ -0,0 +1,129 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genai"
+)
+
+// Answer is the reply to a question asked over a set of notes.
+type Answer struct {
+	Answer    string     `json:"answer"`
+	Citations []Citation `json:"citations"`
+}
+
+// Citation points at the note supporting part of an answer.
+type Citation struct {
+	PrimaryKey string `json:"primary_key"`
+	Excerpt    string `json:"excerpt"`
+}
+
+const askPrompt = `
+You are a helpful assistant answering questions using only the notes provided below.
+If the notes do not contain the answer, say so instead of guessing.
+Cite every note you used by its primary key in square brackets, e.g. [primary-key], inside the answer.
+The notes are:
+%s
+The question is:
+%s
+The response should be in the following format:
+{"answer": "string", "citations": [{"primary_key": "string", "excerpt": "string"}]}
+The excerpt is a short verbatim quote from the cited note that supports the answer.
+`
+
+var answerSchema = &genai.Schema{
+	Type: genai.TypeObject,
+	Properties: map[string]*genai.Schema{
+		"answer": {Type: genai.TypeString},
+		"citations": {
+			Type:  genai.TypeArray,
+			Items: generateSchemaFromStruct[Citation](),
+		},
+	},
+	PropertyOrdering: []string{"answer", "citations"},
+}
+
+// Ask answers question from docs, which should already be ordered by relevance. Documents are
+// included until the prompt token budget is used up. Citations of unknown documents are dropped.
+func Ask(ctx context.Context, provider Provider, docs []Document, question string, maxPromptTokens int) (Answer, error) {
+	if maxPromptTokens <= 0 {
+		maxPromptTokens = DefaultMaxPromptTokens
+	}
+	overhead := EstimateTokens(fmt.Sprintf(askPrompt, "", question))
+	chunks, err := chunkDocuments(docs, maxPromptTokens-overhead)
+	if err != nil {
+		return Answer{}, err
+	}
+	if len(chunks) == 0 {
+		return Answer{}, fmt.Errorf("no notes to answer the question from")
+	}
+	// the most relevant documents come first, so the first chunk is the one worth sending
+	sources := chunks[0]
+
+	jsonDataBytes, err := json.Marshal(sources)
+	if err != nil {
+		return Answer{}, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	result, err := provider.Generate(ctx, Request{
+		Prompt: fmt.Sprintf(askPrompt, string(jsonDataBytes), question),
+		JSON:   true,
+		Schema: answerSchema,
+	})
+	if err != nil {
+		return Answer{}, err
+	}
+
+	var answer Answer
+	if err := json.Unmarshal([]byte(trimCodeFence(result)), &answer); err != nil {
+		return Answer{}, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	known := make(map[string]Document, len(sources))
+	for _, doc := range sources {
+		known[doc.PrimaryKey] = doc
+	}
+	citations := make([]Citation, 0, len(answer.Citations))
+	seen := make(map[string]struct{})
+	for _, citation := range answer.Citations {
+		doc, ok := known[citation.PrimaryKey]
+		if !ok {
+			continue
+		}
+		if _, dup := seen[citation.PrimaryKey]; dup {
+			continue
+		}
+		seen[citation.PrimaryKey] = struct{}{}
+		if strings.TrimSpace(citation.Excerpt) == "" {
+			citation.Excerpt = excerpt(doc.Content, 200)
+		}
+		citations = append(citations, citation)
+	}
+	answer.Citations = citations
+	return answer, nil
+}
+
+// trimCodeFence removes a Markdown code fence that some models wrap JSON replies in.
+func trimCodeFence(text string) string {
+	text = strings.TrimSpace(text)
+	text = strings.TrimPrefix(text, "```json")
+	text = strings.TrimPrefix(text, "```")
+	text = strings.TrimSuffix(text, "```")
+	return strings.TrimSpace(text)
+}
+
+// excerpt returns the first n characters of content, cut at a word boundary.
+func excerpt(content string, n int) string {
+	content = strings.Join(strings.Fields(content), " ")
+	if len(content) <= n {
+		return content
+	}
+	cut := strings.LastIndex(content[:n], " ")
+	if cut <= 0 {
+		cut = n
+	}
+	return strings.ToValidUTF8(content[:cut], "") + "..."
+}
@@ -0,0 +1,77 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRankByText(t *testing.T) {
+	docs := DocumentsFromNotes(readTestNotes(t))
+
+	results := RankByText(docs, "python machine learning", 3)
+	if len(results) == 0 {
+		t.Fatal("Expected at least one result")
+	}
+	if results[0].PrimaryKey != docs[10].PrimaryKey {
+		t.Errorf("Expected 'Python Machine Learning Libraries' note (ID %s) to rank first, got %s", docs[10].PrimaryKey, results[0].PrimaryKey)
+	}
+	if results[0].Score != 1 {
+		t.Errorf("Expected the best match to have score 1, got %f", results[0].Score)
+	}
+
+	if results := RankByText(docs, "the and of", 3); len(results) != 0 {
+		t.Errorf("Expected stop words alone to match nothing, got %d results", len(results))
+	}
+}
+
+func TestAsk(t *testing.T) {
+	docs := []Document{
+		{PrimaryKey: "cache-1", Title: "Cache decision", Content: "We agreed the cache TTL is 5 minutes."},
+		{PrimaryKey: "misc-2", Title: "Lunch", Content: "Pizza on Friday."},
+	}
+	provider := &FakeProvider{
+		Response: "```json\n" + `{"answer": "The TTL is 5 minutes [cache-1].", "citations": [
+			{"primary_key": "cache-1", "excerpt": "cache TTL is 5 minutes"},
+			{"primary_key": "cache-1", "excerpt": "duplicate"},
+			{"primary_key": "unknown", "excerpt": "made up"},
+			{"primary_key": "misc-2", "excerpt": ""}
+		]}` + "\n```",
+	}
+
+	answer, err := Ask(context.Background(), provider, docs, "what did we decide about the cache TTL?", 0)
+	if err != nil {
+		t.Fatalf("Ask returned an error: %v", err)
+	}
+	if !strings.Contains(answer.Answer, "5 minutes") {
+		t.Errorf("Unexpected answer %q", answer.Answer)
+	}
+	if len(answer.Citations) != 2 {
+		t.Fatalf("Expected 2 citations after dropping duplicates and unknown notes, got %v", answer.Citations)
+	}
+	if answer.Citations[1].Excerpt != "Pizza on Friday." {
+		t.Errorf("Expected a missing excerpt to fall back to the note content, got %q", answer.Citations[1].Excerpt)
+	}
+	if !strings.Contains(provider.Requests[0].Prompt, "cache TTL is 5 minutes") {
+		t.Error("Expected the notes to be included in the prompt")
+	}
+}
+
+func TestAskRespectsTokenBudget(t *testing.T) {
+	docs := make([]Document, 50)
+	for i := range docs {
+		docs[i] = Document{PrimaryKey: fmt.Sprintf("note-%d", i), Content: strings.Repeat("words ", 200)}
+	}
+	provider := &FakeProvider{Response: `{"answer": "ok", "citations": []}`}
+
+	if _, err := Ask(context.Background(), provider, docs, "question", 2000); err != nil {
+		t.Fatalf("Ask returned an error: %v", err)
+	}
+	if tokens := EstimateTokens(provider.Requests[0].Prompt); tokens > 2000 {
+		t.Errorf("Expected the prompt to fit the budget, got %d tokens", tokens)
+	}
+	if !strings.Contains(provider.Requests[0].Prompt, "note-0") {
+		t.Error("Expected the most relevant note to be kept")
+	}
+}
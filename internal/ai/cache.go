@@ -0,0 +1,95 @@
+package ai
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached results stay valid when no TTL is configured.
+const DefaultCacheTTL = 24 * time.Hour
+
+// Cache stores the results of AI requests on disk so repeated identical requests are free.
+// Every entry is a JSON file in Dir named after the hash of its key.
+type Cache struct {
+	Dir string
+	TTL time.Duration
+}
+
+type cacheEntry struct {
+	CreatedAt time.Time       `json:"created_at"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// NewCache creates a Cache in dir, falling back to DefaultCacheTTL when ttl is not positive.
+func NewCache(dir string, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{Dir: dir, TTL: ttl}
+}
+
+// CacheKey hashes parts into a key. Parts are length-prefixed so that different splits of the
+// same text produce different keys.
+func CacheKey(parts ...string) string {
+	hasher := sha256.New()
+	for _, part := range parts {
+		fmt.Fprintf(hasher, "%d:%s", len(part), part)
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// CorpusHash identifies a set of documents, so cached results are invalidated when any note changes.
+func CorpusHash(docs []Document) (string, error) {
+	data, err := json.Marshal(docs)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal data: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.Dir, key+".json")
+}
+
+// Get decodes the entry for key into value. It reports false when there is no entry, the entry
+// has expired or it cannot be read.
+func (c *Cache) Get(key string, value any) bool {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return false
+	}
+	if time.Since(entry.CreatedAt) > c.TTL {
+		os.Remove(c.path(key))
+		return false
+	}
+	return json.Unmarshal(entry.Value, value) == nil
+}
+
+// Put stores value under key, creating the cache directory if needed.
+func (c *Cache) Put(key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+	entry, err := json.Marshal(cacheEntry{CreatedAt: time.Now(), Value: data})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry: %w", err)
+	}
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(c.path(key), entry, 0644); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package ai
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSemanticSearchCache(t *testing.T) {
+	docs := []Document{{PrimaryKey: "1", Title: "Go", Content: "Goroutines and channels."}}
+	provider := &FakeProvider{Response: `[{"primary_key": "1", "score": 0.9}]`}
+	opts := SearchOptions{Cache: NewCache(t.TempDir(), time.Hour)}
+
+	for range 2 {
+		results, err := SemanticSearch(context.Background(), provider, docs, "concurrency", opts)
+		if err != nil {
+			t.Fatalf("SemanticSearch returned an error: %v", err)
+		}
+		if len(results) != 1 || results[0].PrimaryKey != "1" {
+			t.Fatalf("Expected the cached result to match, got %v", results)
+		}
+	}
+	if len(provider.Requests) != 1 {
+		t.Errorf("Expected the repeated query to be served from the cache, got %d requests", len(provider.Requests))
+	}
+
+	// a changed note invalidates the entry
+	docs[0].Content = "Goroutines, channels and mutexes."
+	if _, err := SemanticSearch(context.Background(), provider, docs, "concurrency", opts); err != nil {
+		t.Fatalf("SemanticSearch returned an error: %v", err)
+	}
+	if len(provider.Requests) != 2 {
+		t.Errorf("Expected a new request after the corpus changed, got %d requests", len(provider.Requests))
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	cache := NewCache(t.TempDir(), time.Millisecond)
+	if err := cache.Put("key", []string{"a"}); err != nil {
+		t.Fatalf("Put returned an error: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	var value []string
+	if cache.Get("key", &value) {
+		t.Error("Expected the expired entry to be ignored")
+	}
+}
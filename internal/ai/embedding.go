@@ -0,0 +1,121 @@
+package ai
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Embedder turns text into fixed-size vectors that can be compared with cosine similarity.
+type Embedder interface {
+	// Name identifies the embedding model. Vectors from embedders with different names are not comparable.
+	Name() string
+	Embed(texts []string) ([][]float32, error)
+}
+
+// DefaultHashDimensions is the vector size used by the built-in offline embedder.
+const DefaultHashDimensions = 512
+
+// HashEmbedder is an offline Embedder that hashes word counts into a fixed number of dimensions
+// (the "hashing trick"), using log-scaled term frequency with common English stop words removed.
+// There is no inverse document frequency, so no corpus is needed up front.
+type HashEmbedder struct {
+	Dimensions int
+}
+
+// NewHashEmbedder creates a HashEmbedder producing vectors with the given number of dimensions.
+func NewHashEmbedder(dimensions int) *HashEmbedder {
+	return &HashEmbedder{Dimensions: dimensions}
+}
+
+func (h *HashEmbedder) Name() string {
+	return fmt.Sprintf("hash-%d", h.Dimensions)
+}
+
+func (h *HashEmbedder) Embed(texts []string) ([][]float32, error) {
+	if h.Dimensions <= 0 {
+		return nil, fmt.Errorf("hash embedder needs a positive number of dimensions, got %d", h.Dimensions)
+	}
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = h.embed(text)
+	}
+	return vectors, nil
+}
+
+func (h *HashEmbedder) embed(text string) []float32 {
+	counts := make(map[string]int)
+	for _, token := range Tokenize(text) {
+		counts[token]++
+	}
+
+	vector := make([]float32, h.Dimensions)
+	for token, count := range counts {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(token))
+		sum := hasher.Sum64()
+		// the top bit picks the sign so that colliding tokens tend to cancel out instead of piling up
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vector[sum%uint64(h.Dimensions)] += sign * float32(1+math.Log(float64(count)))
+	}
+	normalize(vector)
+	return vector
+}
+
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {},
+	"for": {}, "from": {}, "has": {}, "have": {}, "in": {}, "is": {}, "it": {}, "its": {}, "of": {},
+	"on": {}, "or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {}, "we": {}, "were": {},
+	"what": {}, "with": {},
+}
+
+// Tokenize lower-cases text and splits it into words, dropping punctuation and stop words.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, ok := stopWords[word]; ok {
+			continue
+		}
+		tokens = append(tokens, word)
+	}
+	return tokens
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b, or 0 if either is a zero vector.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+func normalize(vector []float32) {
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm == 0 {
+		return
+	}
+	norm = math.Sqrt(norm)
+	for i := range vector {
+		vector[i] = float32(float64(vector[i]) / norm)
+	}
+}
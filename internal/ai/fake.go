@@ -0,0 +1,34 @@
+package ai
+
+import (
+	"context"
+	"sync"
+)
+
+// FakeProvider is a deterministic Provider for tests. It answers every request with Respond
+// when set, or with Response otherwise, and records the requests it received.
+type FakeProvider struct {
+	Response string
+	Respond  func(req Request) (string, error)
+
+	mu       sync.Mutex
+	Requests []Request
+}
+
+func (f *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (f *FakeProvider) Generate(ctx context.Context, req Request) (string, error) {
+	f.mu.Lock()
+	f.Requests = append(f.Requests, req)
+	f.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if f.Respond != nil {
+		return f.Respond(req)
+	}
+	return f.Response, nil
+}
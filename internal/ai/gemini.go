@@ -0,0 +1,55 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genai"
+)
+
+// DefaultGeminiModel is used when no model is configured for the gemini provider.
+const DefaultGeminiModel = "gemini-2.5-flash"
+
+// GeminiProvider generates completions with the Google Gemini API.
+type GeminiProvider struct {
+	APIKey string
+	Model  string
+}
+
+// NewGeminiProvider creates a GeminiProvider, falling back to DefaultGeminiModel when model is empty.
+func NewGeminiProvider(apiKey string, model string) *GeminiProvider {
+	if model == "" {
+		model = DefaultGeminiModel
+	}
+	return &GeminiProvider{APIKey: apiKey, Model: model}
+}
+
+func (g *GeminiProvider) Name() string {
+	return ProviderGemini + "/" + g.Model
+}
+
+func (g *GeminiProvider) Generate(ctx context.Context, req Request) (string, error) {
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey: g.APIKey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create client: %w", err)
+	}
+
+	config := &genai.GenerateContentConfig{}
+	if req.JSON {
+		config.ResponseMIMEType = "application/json"
+		config.ResponseSchema = req.Schema
+	}
+
+	result, err := client.Models.GenerateContent(
+		ctx,
+		g.Model,
+		genai.Text(req.Prompt),
+		config,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate content: %w", err)
+	}
+	return result.Text(), nil
+}
@@ -0,0 +1,65 @@
+package ai
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RRFConstant dampens the weight of the top ranks in reciprocal rank fusion. 60 is the value
+// from the original paper and works well without tuning.
+const RRFConstant = 60
+
+// HybridResult is a note ranked by both full-text and semantic search.
+type HybridResult struct {
+	PrimaryKey    string
+	Score         float64 // fused score, 1 when the note ranks first in both lists
+	TextScore     float64 // score from the full-text ranking, 0 when absent
+	SemanticScore float64 // score from the semantic ranking, 0 when absent
+}
+
+func (h HybridResult) PrepRow() []string {
+	return []string{
+		h.PrimaryKey,
+		fmt.Sprintf("%.2f %%", h.Score*100),
+		fmt.Sprintf("%.2f %%", h.TextScore*100),
+		fmt.Sprintf("%.2f %%", h.SemanticScore*100),
+	}
+}
+
+// FuseRankings merges a full-text and a semantic ranking with reciprocal rank fusion: every note
+// scores 1/(RRFConstant+rank) for each list it appears in. Only ranks matter, so the lists do not
+// need comparable scores. Up to limit results are returned, best first.
+func FuseRankings(text []SearchResponse, semantic []SearchResponse, limit int) []HybridResult {
+	fused := make(map[string]*HybridResult)
+	add := func(ranking []SearchResponse, setScore func(*HybridResult, float64)) {
+		for rank, res := range ranking {
+			h, ok := fused[res.PrimaryKey]
+			if !ok {
+				h = &HybridResult{PrimaryKey: res.PrimaryKey}
+				fused[res.PrimaryKey] = h
+			}
+			h.Score += 1 / float64(RRFConstant+rank+1)
+			setScore(h, res.Score)
+		}
+	}
+	add(text, func(h *HybridResult, score float64) { h.TextScore = score })
+	add(semantic, func(h *HybridResult, score float64) { h.SemanticScore = score })
+
+	// rank one in both lists is the best possible score
+	best := 2 / float64(RRFConstant+1)
+	results := make([]HybridResult, 0, len(fused))
+	for _, h := range fused {
+		h.Score /= best
+		results = append(results, *h)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score == results[j].Score {
+			return results[i].PrimaryKey < results[j].PrimaryKey
+		}
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
@@ -0,0 +1,29 @@
+package ai
+
+import "testing"
+
+func TestFuseRankings(t *testing.T) {
+	text := []SearchResponse{{PrimaryKey: "a", Score: 1}, {PrimaryKey: "b", Score: 0.8}, {PrimaryKey: "c", Score: 0.2}}
+	semantic := []SearchResponse{{PrimaryKey: "b", Score: 0.9}, {PrimaryKey: "d", Score: 0.7}}
+
+	results := FuseRankings(text, semantic, 10)
+	if len(results) != 4 {
+		t.Fatalf("Expected 4 fused results, got %d", len(results))
+	}
+	if results[0].PrimaryKey != "b" {
+		t.Errorf("Expected the note found by both rankings first, got %s", results[0].PrimaryKey)
+	}
+	if results[0].TextScore != 0.8 || results[0].SemanticScore != 0.9 {
+		t.Errorf("Expected both original scores to be kept, got %+v", results[0])
+	}
+	for _, res := range results {
+		if res.Score <= 0 || res.Score > 1 {
+			t.Errorf("Expected fused scores in (0, 1], got %v for %s", res.Score, res.PrimaryKey)
+		}
+	}
+
+	top := FuseRankings(text[:1], []SearchResponse{{PrimaryKey: "a", Score: 0.5}}, 1)
+	if len(top) != 1 || top[0].Score != 1 {
+		t.Errorf("Expected a note ranked first in both lists to score 1, got %+v", top)
+	}
+}
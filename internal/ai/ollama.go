@@ -0,0 +1,70 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/requests"
+)
+
+// Defaults for the ollama provider.
+const (
+	DefaultOllamaBaseURL = "http://localhost:11434"
+	DefaultOllamaModel   = "llama3.1"
+)
+
+// OllamaProvider generates completions with a local Ollama-style server.
+type OllamaProvider struct {
+	BaseURL string
+	Model   string
+}
+
+// NewOllamaProvider creates an OllamaProvider, filling in the default base URL and model when empty.
+func NewOllamaProvider(baseURL string, model string) *OllamaProvider {
+	if baseURL == "" {
+		baseURL = DefaultOllamaBaseURL
+	}
+	if model == "" {
+		model = DefaultOllamaModel
+	}
+	return &OllamaProvider{BaseURL: strings.TrimRight(baseURL, "/"), Model: model}
+}
+
+func (o *OllamaProvider) Name() string {
+	return ProviderOllama + "/" + o.Model
+}
+
+type ollamaGenerateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+	Format string `json:"format,omitempty"`
+}
+
+type ollamaGenerateResponse struct {
+	Response string `json:"response"`
+}
+
+func (o *OllamaProvider) Generate(ctx context.Context, req Request) (string, error) {
+	genReq := ollamaGenerateRequest{Model: o.Model, Prompt: req.Prompt}
+	if req.JSON {
+		genReq.Format = "json"
+	}
+	payload, err := json.Marshal(genReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	body, err := requests.PostJSON(ctx, o.BaseURL+"/api/generate", nil, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate content: %w", err)
+	}
+
+	var genRes ollamaGenerateResponse
+	if err := json.Unmarshal(body, &genRes); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return genRes.Response, nil
+}
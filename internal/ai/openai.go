@@ -0,0 +1,91 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/requests"
+)
+
+// Defaults for the openai provider.
+const (
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+	DefaultOpenAIModel   = "gpt-4o-mini"
+)
+
+// OpenAIProvider generates completions with any server implementing the OpenAI chat/completions API.
+type OpenAIProvider struct {
+	BaseURL string
+	APIKey  string
+	Model   string
+}
+
+// NewOpenAIProvider creates an OpenAIProvider, filling in the default base URL and model when empty.
+func NewOpenAIProvider(baseURL string, apiKey string, model string) *OpenAIProvider {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+	if model == "" {
+		model = DefaultOpenAIModel
+	}
+	return &OpenAIProvider{BaseURL: strings.TrimRight(baseURL, "/"), APIKey: apiKey, Model: model}
+}
+
+func (o *OpenAIProvider) Name() string {
+	return ProviderOpenAI + "/" + o.Model
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIResponseFormat struct {
+	Type string `json:"type"`
+}
+
+type openAIChatRequest struct {
+	Model          string                `json:"model"`
+	Messages       []openAIMessage       `json:"messages"`
+	ResponseFormat *openAIResponseFormat `json:"response_format,omitempty"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (o *OpenAIProvider) Generate(ctx context.Context, req Request) (string, error) {
+	chatReq := openAIChatRequest{
+		Model:    o.Model,
+		Messages: []openAIMessage{{Role: "user", Content: req.Prompt}},
+	}
+	if req.JSON {
+		chatReq.ResponseFormat = &openAIResponseFormat{Type: "json_object"}
+	}
+	payload, err := json.Marshal(chatReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	headers := map[string]string{}
+	if o.APIKey != "" {
+		headers["Authorization"] = "Bearer " + o.APIKey
+	}
+	body, err := requests.PostJSON(ctx, o.BaseURL+"/chat/completions", headers, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate content: %w", err)
+	}
+
+	var chatRes openAIChatResponse
+	if err := json.Unmarshal(body, &chatRes); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	if len(chatRes.Choices) == 0 {
+		return "", fmt.Errorf("failed to generate content: response contained no choices")
+	}
+	return chatRes.Choices[0].Message.Content, nil
+}
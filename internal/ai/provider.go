@@ -0,0 +1,56 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genai"
+)
+
+// Request is a single prompt sent to an LLM provider.
+type Request struct {
+	Prompt string
+	// JSON asks the provider to reply with a JSON document only.
+	JSON bool
+	// Schema describes the expected JSON reply. Providers without structured output support ignore it.
+	Schema *genai.Schema
+}
+
+// Provider generates text completions from a large language model.
+type Provider interface {
+	// Name identifies the provider and model, e.g. "gemini/gemini-2.5-flash".
+	Name() string
+	Generate(ctx context.Context, req Request) (string, error)
+}
+
+// Supported provider names for ProviderConfig.Provider.
+const (
+	ProviderGemini = "gemini"
+	ProviderOpenAI = "openai"
+	ProviderOllama = "ollama"
+)
+
+// ProviderConfig selects and configures a Provider.
+type ProviderConfig struct {
+	Provider string // one of gemini, openai or ollama; defaults to gemini
+	Model    string // defaults to a provider specific model
+	APIKey   string
+	BaseURL  string // endpoint for openai-compatible and ollama servers
+}
+
+// NewProvider creates the provider described by cfg.
+func NewProvider(cfg ProviderConfig) (Provider, error) {
+	switch cfg.Provider {
+	case "", ProviderGemini:
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("gemini API key not configured")
+		}
+		return NewGeminiProvider(cfg.APIKey, cfg.Model), nil
+	case ProviderOpenAI:
+		return NewOpenAIProvider(cfg.BaseURL, cfg.APIKey, cfg.Model), nil
+	case ProviderOllama:
+		return NewOllamaProvider(cfg.BaseURL, cfg.Model), nil
+	default:
+		return nil, fmt.Errorf("unknown AI provider '%s'. Supported providers: gemini, openai, ollama", cfg.Provider)
+	}
+}
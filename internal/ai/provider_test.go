@@ -0,0 +1,98 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         ProviderConfig
+		expected    string
+		expectError bool
+	}{
+		{name: "default is gemini", cfg: ProviderConfig{APIKey: "key"}, expected: "gemini/" + DefaultGeminiModel},
+		{name: "gemini without key", cfg: ProviderConfig{Provider: ProviderGemini}, expectError: true},
+		{name: "openai with model", cfg: ProviderConfig{Provider: ProviderOpenAI, Model: "local-model"}, expected: "openai/local-model"},
+		{name: "ollama default model", cfg: ProviderConfig{Provider: ProviderOllama}, expected: "ollama/" + DefaultOllamaModel},
+		{name: "unknown provider", cfg: ProviderConfig{Provider: "nope"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewProvider(tt.cfg)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected an error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if provider.Name() != tt.expected {
+				t.Errorf("Expected provider %q, got %q", tt.expected, provider.Name())
+			}
+		})
+	}
+}
+
+func TestOpenAIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("Expected bearer token, got %q", r.Header.Get("Authorization"))
+		}
+		var req openAIChatRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.ResponseFormat == nil || req.ResponseFormat.Type != "json_object" {
+			t.Error("Expected JSON response format to be requested")
+		}
+		w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "echo: ` + req.Messages[0].Content + `"}}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(server.URL+"/v1/", "secret", "")
+	out, err := provider.Generate(context.Background(), Request{Prompt: "hello", JSON: true})
+	if err != nil {
+		t.Fatalf("Generate returned an error: %v", err)
+	}
+	if out != "echo: hello" {
+		t.Errorf("Expected %q, got %q", "echo: hello", out)
+	}
+}
+
+func TestOllamaProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/generate" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		var req ollamaGenerateRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Stream || req.Model != DefaultOllamaModel {
+			t.Errorf("Unexpected request %+v", req)
+		}
+		w.Write([]byte(`{"response": "hi there"}`))
+	}))
+	defer server.Close()
+
+	provider := NewOllamaProvider(server.URL, "")
+	out, err := provider.Generate(context.Background(), Request{Prompt: "hello"})
+	if err != nil {
+		t.Fatalf("Generate returned an error: %v", err)
+	}
+	if out != "hi there" {
+		t.Errorf("Expected %q, got %q", "hi there", out)
+	}
+
+	server.Close()
+	if _, err := provider.Generate(context.Background(), Request{Prompt: "hello"}); err == nil {
+		t.Error("Expected an error once the server is gone")
+	}
+}
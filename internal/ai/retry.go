@@ -0,0 +1,124 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/requests"
+	"google.golang.org/genai"
+)
+
+// Defaults for RetryPolicy.
+const (
+	DefaultTimeout        = 60 * time.Second
+	DefaultMaxRetries     = 3
+	DefaultInitialBackoff = time.Second
+	DefaultMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy controls how a RetryProvider times out and retries requests.
+type RetryPolicy struct {
+	Timeout        time.Duration // limit for a single attempt
+	MaxRetries     int           // retries after the first attempt; 0 or less disables retrying
+	InitialBackoff time.Duration // wait before the first retry, doubled for every further retry
+	MaxBackoff     time.Duration // upper bound for the wait between retries
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultTimeout
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = DefaultInitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = DefaultMaxBackoff
+	}
+	return p
+}
+
+// RetryProvider wraps a Provider with a timeout per attempt and exponential backoff on
+// rate limits, server errors and network failures.
+type RetryProvider struct {
+	Provider Provider
+	Policy   RetryPolicy
+}
+
+// WithRetry wraps provider so its requests follow policy. Unset timeout and backoff fields use the
+// defaults; MaxRetries is used as given, so a zero policy does not retry.
+func WithRetry(provider Provider, policy RetryPolicy) *RetryProvider {
+	return &RetryProvider{Provider: provider, Policy: policy.withDefaults()}
+}
+
+func (r *RetryProvider) Name() string {
+	return r.Provider.Name()
+}
+
+func (r *RetryProvider) Generate(ctx context.Context, req Request) (string, error) {
+	backoff := r.Policy.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, r.Policy.Timeout)
+		result, err := r.Provider.Generate(attemptCtx, req)
+		cancel()
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			// cancelled by the caller, e.g. Ctrl-C
+			return "", ctx.Err()
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("request timed out after %s: %w", r.Policy.Timeout, err)
+		}
+		if attempt >= r.Policy.MaxRetries || !IsRetryable(err) {
+			return "", err
+		}
+
+		// jitter keeps concurrent chunk requests from retrying in lockstep
+		wait := time.Duration(rand.Int64N(int64(backoff))) + backoff/2
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+		backoff = min(2*backoff, r.Policy.MaxBackoff)
+	}
+}
+
+// IsRetryable reports whether err is worth retrying: timeouts, network failures, rate limits
+// and server errors. Errors such as invalid API keys or malformed requests are not.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var statusErr *requests.StatusError
+	if errors.As(err, &statusErr) {
+		return retryableStatus(statusErr.StatusCode)
+	}
+	var apiErr genai.APIError
+	if errors.As(err, &apiErr) {
+		return retryableStatus(apiErr.Code)
+	}
+	var apiErrPtr *genai.APIError
+	if errors.As(err, &apiErrPtr) {
+		return retryableStatus(apiErrPtr.Code)
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusRequestTimeout || code >= 500
+}
@@ -0,0 +1,103 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/requests"
+)
+
+func TestRetryProvider(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+	t.Run("retries rate limits", func(t *testing.T) {
+		calls := 0
+		fake := &FakeProvider{Respond: func(req Request) (string, error) {
+			calls++
+			if calls < 3 {
+				return "", fmt.Errorf("failed to generate content: %w", &requests.StatusError{StatusCode: http.StatusTooManyRequests})
+			}
+			return "ok", nil
+		}}
+		result, err := WithRetry(fake, policy).Generate(context.Background(), Request{Prompt: "p"})
+		if err != nil || result != "ok" {
+			t.Fatalf("Expected ok after retries, got %q, %v", result, err)
+		}
+		if calls != 3 {
+			t.Errorf("Expected 3 attempts, got %d", calls)
+		}
+	})
+
+	t.Run("gives up on permanent errors", func(t *testing.T) {
+		fake := &FakeProvider{Respond: func(req Request) (string, error) {
+			return "", &requests.StatusError{StatusCode: http.StatusUnauthorized}
+		}}
+		if _, err := WithRetry(fake, policy).Generate(context.Background(), Request{}); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if len(fake.Requests) != 1 {
+			t.Errorf("Expected a single attempt, got %d", len(fake.Requests))
+		}
+	})
+
+	t.Run("stops after max retries", func(t *testing.T) {
+		fake := &FakeProvider{Respond: func(req Request) (string, error) {
+			return "", &requests.StatusError{StatusCode: http.StatusServiceUnavailable}
+		}}
+		if _, err := WithRetry(fake, policy).Generate(context.Background(), Request{}); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if len(fake.Requests) != 4 {
+			t.Errorf("Expected 4 attempts, got %d", len(fake.Requests))
+		}
+	})
+
+	t.Run("zero max retries makes a single attempt", func(t *testing.T) {
+		fake := &FakeProvider{Respond: func(req Request) (string, error) {
+			return "", &requests.StatusError{StatusCode: http.StatusServiceUnavailable}
+		}}
+		noRetries := policy
+		noRetries.MaxRetries = 0
+		if _, err := WithRetry(fake, noRetries).Generate(context.Background(), Request{}); err == nil {
+			t.Fatal("Expected an error")
+		}
+		if len(fake.Requests) != 1 {
+			t.Errorf("Expected a single attempt, got %d", len(fake.Requests))
+		}
+	})
+
+	t.Run("times out slow attempts", func(t *testing.T) {
+		slow := &slowProvider{}
+		timeout := RetryPolicy{Timeout: 5 * time.Millisecond, MaxRetries: -1}
+		_, err := WithRetry(slow, timeout).Generate(context.Background(), Request{})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected a deadline error, got %v", err)
+		}
+	})
+
+	t.Run("honours cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		fake := &FakeProvider{Response: "ok"}
+		if _, err := WithRetry(fake, policy).Generate(ctx, Request{}); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if len(fake.Requests) != 1 {
+			t.Errorf("Expected no retries after cancellation, got %d attempts", len(fake.Requests))
+		}
+	})
+}
+
+// slowProvider blocks until its context is done.
+type slowProvider struct{}
+
+func (s *slowProvider) Name() string { return "slow" }
+
+func (s *slowProvider) Generate(ctx context.Context, req Request) (string, error) {
+	<-ctx.Done()
+	return "", ctx.Err()
+}
@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/landanqrew/simple-jot/internal/notes"
 	"google.golang.org/genai"
 )
 
@@ -59,23 +63,52 @@ func generateSchemaFromStruct[T any]() *genai.Schema {
 	}
 }
 
-func SemanticSearch[T any](data []T, naturalLanguageQuery string, apiKey string) ([]SearchResponse, error) {
-    // marshall json
-    jsonDataBytes, err := json.Marshal(data)
-    if err != nil {
-        return nil, fmt.Errorf("failed to marshal data: %w", err)
-    }
-    jsonData := string(jsonDataBytes)
+// Defaults for SearchOptions.
+const (
+	DefaultMaxPromptTokens = 8000
+	DefaultConcurrency     = 4
+	DefaultSearchLimit     = 10
+)
 
-    
-	ctx := context.Background()
-	client, err := genai.NewClient(ctx, &genai.ClientConfig{
-		APIKey: apiKey,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create client: %w", err)
+// SearchOptions controls how SemanticSearch splits the documents across requests.
+type SearchOptions struct {
+	MaxPromptTokens int    // token budget for a single prompt, including the instructions and query
+	Concurrency     int    // maximum number of requests in flight
+	Limit           int    // maximum number of results returned
+	Cache           *Cache // optional cache of results; nil disables caching
+}
+
+func (o SearchOptions) withDefaults() SearchOptions {
+	if o.MaxPromptTokens <= 0 {
+		o.MaxPromptTokens = DefaultMaxPromptTokens
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = DefaultConcurrency
+	}
+	if o.Limit <= 0 {
+		o.Limit = DefaultSearchLimit
+	}
+	return o
+}
+
+// Document is the subset of a note that is sent to the model.
+type Document struct {
+	PrimaryKey string   `json:"primary_key"`
+	Title      string   `json:"title"`
+	Tags       []string `json:"tags,omitempty"`
+	Content    string   `json:"content"`
+}
+
+// DocumentsFromNotes converts notes into the documents sent to the model, dropping fields such as timestamps.
+func DocumentsFromNotes(noteList []notes.Note) []Document {
+	docs := make([]Document, len(noteList))
+	for i, note := range noteList {
+		docs[i] = Document{PrimaryKey: note.ID, Title: note.Title, Tags: note.Tags, Content: note.Content}
 	}
-	sysPrompt := fmt.Sprintf(`
+	return docs
+}
+
+const semanticSearchPrompt = `
 You are a helpful assistant that can answer questions and evaluate data in accordance with the provided schema.
 You are given a list of data and a natural language query.
 You need to answer the question based on the data.
@@ -87,31 +120,227 @@ The response should be in the following format:
 [{"primary_key": "string", "score": float64}]
 The score is a float64 between 0 and 1, where 1 is the best match.
 The primary key is the primary key of the data. Return no more than 10 results. Return the results with the strongest match. Do not return any results with a score less than 0.5.
-`, jsonData, naturalLanguageQuery)
+`
+
+// SemanticSearch asks provider to rank docs by relevance to naturalLanguageQuery. The documents are
+// split into chunks that fit the prompt token budget, the chunks are ranked concurrently, and the
+// per-chunk results are merged into a single list of at most opts.Limit results. When opts.Cache
+// is set, results are cached by query, provider and a hash of docs.
+func SemanticSearch(ctx context.Context, provider Provider, docs []Document, naturalLanguageQuery string, opts SearchOptions) ([]SearchResponse, error) {
+	opts = opts.withDefaults()
+	if opts.Cache == nil {
+		return semanticSearch(ctx, provider, docs, naturalLanguageQuery, opts)
+	}
+
+	corpus, err := CorpusHash(docs)
+	if err != nil {
+		return nil, err
+	}
+	key := CacheKey("semantic-search", provider.Name(), naturalLanguageQuery, corpus, fmt.Sprint(opts.Limit))
+	var cached []SearchResponse
+	if opts.Cache.Get(key, &cached) {
+		return cached, nil
+	}
+	results, err := semanticSearch(ctx, provider, docs, naturalLanguageQuery, opts)
+	if err != nil {
+		return nil, err
+	}
+	// a failed write only costs a repeated request next time
+	_ = opts.Cache.Put(key, results)
+	return results, nil
+}
+
+func semanticSearch(ctx context.Context, provider Provider, docs []Document, naturalLanguageQuery string, opts SearchOptions) ([]SearchResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	overhead := EstimateTokens(fmt.Sprintf(semanticSearchPrompt, "", naturalLanguageQuery))
+	chunks, err := chunkDocuments(docs, opts.MaxPromptTokens-overhead)
+	if err != nil {
+		return nil, err
+	}
+
+	perChunk := make([][]SearchResponse, len(chunks))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var firstErr error
+	for w := 0; w < min(opts.Concurrency, len(chunks)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				responses, err := rankChunk(ctx, provider, chunks[i], naturalLanguageQuery)
+				if err != nil {
+					// keep the error that caused the cancellation rather than the ones it triggers
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				perChunk[i] = responses
+			}
+		}()
+	}
+	for i := range chunks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return mergeSearchResponses(docs, perChunk, opts.Limit), nil
+}
 
-	config := &genai.GenerateContentConfig{
-		ResponseMIMEType: "application/json",
-		ResponseSchema: &genai.Schema{
+// rankChunk sends a single chunk of documents to the provider.
+func rankChunk(ctx context.Context, provider Provider, chunk []Document, naturalLanguageQuery string) ([]SearchResponse, error) {
+	// marshall json
+	jsonDataBytes, err := json.Marshal(chunk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	result, err := provider.Generate(ctx, Request{
+		Prompt: fmt.Sprintf(semanticSearchPrompt, string(jsonDataBytes), naturalLanguageQuery),
+		JSON:   true,
+		Schema: &genai.Schema{
 			Type:  genai.TypeArray,
 			Items: generateSchemaFromStruct[SearchResponse](),
 		},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := client.Models.GenerateContent(
-		ctx,
-		"gemini-2.5-flash",
-		genai.Text(sysPrompt),
-		config,
-	)
+	searchResponses, err := decodeJSONList[SearchResponse](result)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate content: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return searchResponses, nil
+}
+
+// EstimateTokens approximates the number of tokens in text using the common four characters per token rule.
+func EstimateTokens(text string) int {
+	return len(text)/4 + 1
+}
+
+// chunkDocuments packs docs into chunks whose JSON encoding fits within budget tokens.
+// Documents that do not fit on their own have their content truncated.
+func chunkDocuments(docs []Document, budget int) ([][]Document, error) {
+	if budget <= 0 {
+		return nil, fmt.Errorf("prompt token budget is too small for the search instructions")
+	}
+
+	chunks := make([][]Document, 0)
+	current := make([]Document, 0)
+	used := 0
+	for _, doc := range docs {
+		cost, err := documentTokens(doc)
+		if err != nil {
+			return nil, err
+		}
+		if cost > budget {
+			doc, cost = truncateDocument(doc, budget)
+		}
+		if used+cost > budget && len(current) > 0 {
+			chunks = append(chunks, current)
+			current = make([]Document, 0)
+			used = 0
+		}
+		current = append(current, doc)
+		used += cost
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
 	}
+	return chunks, nil
+}
 
-	var searchResponses []SearchResponse
-	err = json.Unmarshal([]byte(result.Text()), &searchResponses)
+func documentTokens(doc Document) (int, error) {
+	data, err := json.Marshal(doc)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		return 0, fmt.Errorf("failed to marshal data: %w", err)
 	}
+	return EstimateTokens(string(data)), nil
+}
 
-	return searchResponses, nil
-}
\ No newline at end of file
+// truncateDocument shortens the content of doc so that it fits within budget tokens. The cost is
+// measured on the JSON encoding, so quotes, newlines and other escaped characters count at their
+// escaped length rather than as single bytes.
+func truncateDocument(doc Document, budget int) (Document, int) {
+	content := doc.Content
+	cut := func(n int) Document {
+		doc.Content = strings.ToValidUTF8(content[:n], "")
+		return doc
+	}
+	// the shortest prefix that no longer fits, less one byte, is the longest one that does
+	keep := sort.Search(len(content)+1, func(n int) bool {
+		cost, _ := documentTokens(cut(n))
+		return cost > budget
+	}) - 1
+	doc = cut(max(keep, 0))
+	cost, _ := documentTokens(doc)
+	return doc, cost
+}
+
+// mergeSearchResponses combines per-chunk rankings into one list ordered by score. Results whose
+// primary key does not belong to any document are dropped, and duplicates keep their best score.
+func mergeSearchResponses(docs []Document, perChunk [][]SearchResponse, limit int) []SearchResponse {
+	known := make(map[string]struct{}, len(docs))
+	for _, doc := range docs {
+		known[doc.PrimaryKey] = struct{}{}
+	}
+
+	best := make(map[string]float64)
+	for _, responses := range perChunk {
+		for _, res := range responses {
+			if _, ok := known[res.PrimaryKey]; !ok {
+				continue
+			}
+			if score, ok := best[res.PrimaryKey]; !ok || res.Score > score {
+				best[res.PrimaryKey] = res.Score
+			}
+		}
+	}
+
+	merged := make([]SearchResponse, 0, len(best))
+	for key, score := range best {
+		merged = append(merged, SearchResponse{PrimaryKey: key, Score: score})
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].Score == merged[j].Score {
+			return merged[i].PrimaryKey < merged[j].PrimaryKey
+		}
+		return merged[i].Score > merged[j].Score
+	})
+	if limit > 0 && len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
+
+// decodeJSONList parses a JSON array out of an LLM reply. Providers without structured output
+// may wrap the array in a Markdown code fence or in an object such as {"results": [...]}.
+func decodeJSONList[T any](text string) ([]T, error) {
+	text = trimCodeFence(text)
+
+	var list []T
+	arrErr := json.Unmarshal([]byte(text), &list)
+	if arrErr == nil {
+		return list, nil
+	}
+
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(text), &wrapper); err != nil {
+		return nil, arrErr
+	}
+	for _, raw := range wrapper {
+		if err := json.Unmarshal(raw, &list); err == nil {
+			return list, nil
+		}
+	}
+	return nil, arrErr
+}
@@ -1,22 +1,20 @@
 package ai
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/landanqrew/simple-jot/internal/notes"
 	"github.com/landanqrew/simple-jot/tabler"
 )
 
-func TestSemanticSearch(t *testing.T) {
-	apiKey := os.Getenv("GEMINI_API_KEY")
-	if apiKey == "" {
-		t.Fatalf("GEMINI_API_KEY not set, please run \n`export GEMINI_API_KEY=<your-api-key>`\nand run the test again")
-	}
-
-	// Read notes from testNotes.json
+// readTestNotes loads the generated notes shared by the ai tests.
+func readTestNotes(t *testing.T) []notes.Note {
+	t.Helper()
 	filePath := "../notes/testNotes.json"
 	jsonData, err := os.ReadFile(filePath)
 	if err != nil {
@@ -28,12 +26,49 @@ func TestSemanticSearch(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to unmarshal notes from testNotes.json: %v", err)
 	}
+	return data
+}
+
+func TestSemanticSearch(t *testing.T) {
+	data := readTestNotes(t)
+	query := "Rank notes by relevance to 'Go Programming'."
+
+	provider := &FakeProvider{
+		Response: fmt.Sprintf(`{"results": [{"primary_key": %q, "score": 0.92}, {"primary_key": %q, "score": 0.55}]}`, data[5].ID, data[0].ID),
+	}
+	results, err := SemanticSearch(context.Background(), provider, DocumentsFromNotes(data), query, SearchOptions{})
+	if err != nil {
+		t.Fatalf("SemanticSearch returned an error: %v", err)
+	}
+	if len(results) != 2 || results[0].PrimaryKey != data[5].ID || results[0].Score != 0.92 {
+		t.Errorf("Expected the fake ranking to be returned unchanged, got %v", results)
+	}
+
+	if len(provider.Requests) != 1 {
+		t.Fatalf("Expected 1 request to the provider, got %d", len(provider.Requests))
+	}
+	req := provider.Requests[0]
+	if !req.JSON || req.Schema == nil {
+		t.Error("Expected a JSON request with a response schema")
+	}
+	if !strings.Contains(req.Prompt, query) || !strings.Contains(req.Prompt, data[5].ID) {
+		t.Error("Expected the prompt to contain the query and the notes")
+	}
+}
+
+func TestSemanticSearchGemini(t *testing.T) {
+	apiKey := os.Getenv("GEMINI_API_KEY")
+	if apiKey == "" {
+		t.Skip("GEMINI_API_KEY not set, skipping live Gemini test")
+	}
+
+	data := readTestNotes(t)
 	fmt.Println("notes identified: ", len(data))
 
 	query := "Rank notes by relevance to 'Go Programming'."
 
 	var results []SearchResponse
-	results, err = SemanticSearch(data, query, apiKey)
+	results, err := SemanticSearch(context.Background(), NewGeminiProvider(apiKey, ""), DocumentsFromNotes(data), query, SearchOptions{})
 	if err != nil {
 		t.Fatalf("SemanticSearch returned an error: %v", err)
 	}
@@ -67,3 +102,85 @@ func TestSemanticSearch(t *testing.T) {
 		t.Errorf("Expected 'Go Programming Best Practices' note (ID %s) to be highly ranked, but it wasn't or score was too low", expectedGoNoteID)
 	}
 }
+
+func TestSemanticSearchChunksLargeStores(t *testing.T) {
+	data := readTestNotes(t)
+	docs := DocumentsFromNotes(data)
+	scores := make(map[string]float64, len(docs))
+	for i, doc := range docs {
+		scores[doc.PrimaryKey] = 0.5 + float64(i)/float64(2*len(docs))
+	}
+
+	provider := &FakeProvider{
+		// score every document found in the chunk and add one key that does not exist
+		Respond: func(req Request) (string, error) {
+			results := []SearchResponse{{PrimaryKey: "hallucinated-id", Score: 1}}
+			for key, score := range scores {
+				if strings.Contains(req.Prompt, key) {
+					results = append(results, SearchResponse{PrimaryKey: key, Score: score})
+				}
+			}
+			out, err := json.Marshal(results)
+			return string(out), err
+		},
+	}
+
+	results, err := SemanticSearch(context.Background(), provider, docs, "anything", SearchOptions{MaxPromptTokens: 600, Concurrency: 3, Limit: 5})
+	if err != nil {
+		t.Fatalf("SemanticSearch returned an error: %v", err)
+	}
+	if len(provider.Requests) < 2 {
+		t.Fatalf("Expected the documents to be split across several requests, got %d", len(provider.Requests))
+	}
+	for _, req := range provider.Requests {
+		if EstimateTokens(req.Prompt) > 600 {
+			t.Errorf("Prompt exceeds the token budget: %d tokens", EstimateTokens(req.Prompt))
+		}
+		if strings.Contains(req.Prompt, "created_at") {
+			t.Error("Expected timestamps to be left out of the prompt")
+		}
+	}
+
+	if len(results) != 5 {
+		t.Fatalf("Expected 5 merged results, got %d", len(results))
+	}
+	for i, res := range results {
+		if res.PrimaryKey == "hallucinated-id" {
+			t.Error("Expected unknown primary keys to be dropped")
+		}
+		if expected := docs[len(docs)-1-i].PrimaryKey; res.PrimaryKey != expected {
+			t.Errorf("Expected result %d to be %s, got %s", i, expected, res.PrimaryKey)
+		}
+	}
+}
+
+func TestSemanticSearchProviderError(t *testing.T) {
+	data := readTestNotes(t)
+	provider := &FakeProvider{
+		Respond: func(req Request) (string, error) {
+			return "", fmt.Errorf("quota exceeded")
+		},
+	}
+	_, err := SemanticSearch(context.Background(), provider, DocumentsFromNotes(data), "anything", SearchOptions{MaxPromptTokens: 600})
+	if err == nil || !strings.Contains(err.Error(), "quota exceeded") {
+		t.Errorf("Expected the provider error to be returned, got %v", err)
+	}
+}
+
+func TestTruncateDocumentCountsEscapes(t *testing.T) {
+	// every character here is escaped in JSON, taking two or six bytes instead of one
+	doc := Document{PrimaryKey: "key", Title: "escapes", Content: strings.Repeat("\"<\n\\&\t", 200)}
+	for _, budget := range []int{20, 50, 200} {
+		truncated, cost := truncateDocument(doc, budget)
+		data, err := json.Marshal(truncated)
+		if err != nil {
+			t.Fatalf("Failed to marshal the truncated document: %v", err)
+		}
+		if cost > budget || EstimateTokens(string(data)) > budget {
+			t.Errorf("Expected the document to fit in %d tokens, got %d", budget, EstimateTokens(string(data)))
+		}
+		if truncated.Content == "" || !strings.HasPrefix(doc.Content, truncated.Content) {
+			t.Errorf("Expected a non-empty prefix of the content for budget %d, got %q", budget, truncated.Content)
+		}
+	}
+}
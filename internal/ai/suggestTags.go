@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagSuggestion holds the tags and title proposed for a note.
+type TagSuggestion struct {
+	Tags  []string `json:"tags"`
+	Title string   `json:"title"`
+}
+
+const suggestTagsPrompt = `
+You are a helpful assistant that organises notes with tags.
+Suggest between 1 and 5 short, lower-case tags for the note below.
+Strongly prefer tags from the existing vocabulary, and only invent a new tag when none of them fit.
+Also suggest a better, concise title for the note, or return an empty title if the current one is already good.
+The existing tag vocabulary is:
+%s
+The note is:
+%s
+The response should be in the following format:
+{"tags": ["string"], "title": "string"}
+`
+
+// SuggestTags asks provider for tags and an optional better title for doc. Suggested tags that match
+// the vocabulary case-insensitively use the vocabulary spelling, and tags the note already has are dropped.
+func SuggestTags(ctx context.Context, provider Provider, doc Document, vocabulary []string) (TagSuggestion, error) {
+	vocabJSON, err := json.Marshal(vocabulary)
+	if err != nil {
+		return TagSuggestion{}, fmt.Errorf("failed to marshal tags: %w", err)
+	}
+	docJSON, err := json.Marshal(doc)
+	if err != nil {
+		return TagSuggestion{}, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	result, err := provider.Generate(ctx, Request{
+		Prompt: fmt.Sprintf(suggestTagsPrompt, string(vocabJSON), string(docJSON)),
+		JSON:   true,
+		Schema: generateSchemaFromStruct[TagSuggestion](),
+	})
+	if err != nil {
+		return TagSuggestion{}, err
+	}
+
+	var suggestion TagSuggestion
+	if err := json.Unmarshal([]byte(trimCodeFence(result)), &suggestion); err != nil {
+		return TagSuggestion{}, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	canonical := make(map[string]string, len(vocabulary))
+	for _, tag := range vocabulary {
+		canonical[strings.ToLower(tag)] = tag
+	}
+	tags := make([]string, 0, len(suggestion.Tags))
+	for _, tag := range suggestion.Tags {
+		tag = strings.TrimSpace(tag)
+		if existing, ok := canonical[strings.ToLower(tag)]; ok {
+			tag = existing
+		}
+		if tag == "" || slices.Contains(doc.Tags, tag) || slices.Contains(tags, tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	suggestion.Tags = tags
+
+	suggestion.Title = strings.TrimSpace(suggestion.Title)
+	if suggestion.Title == doc.Title {
+		suggestion.Title = ""
+	}
+	return suggestion, nil
+}
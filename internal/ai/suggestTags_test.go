@@ -0,0 +1,45 @@
+package ai
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSuggestTags(t *testing.T) {
+	doc := Document{PrimaryKey: "1", Title: "notes", Tags: []string{"go"}, Content: "Worker pools with goroutines and channels."}
+	vocabulary := []string{"Concurrency", "go", "python"}
+	provider := &FakeProvider{
+		Response: `{"tags": ["concurrency", "go", "channels", " channels ", ""], "title": "Go worker pools"}`,
+	}
+
+	suggestion, err := SuggestTags(context.Background(), provider, doc, vocabulary)
+	if err != nil {
+		t.Fatalf("SuggestTags returned an error: %v", err)
+	}
+
+	expected := []string{"Concurrency", "channels"}
+	if !slices.Equal(suggestion.Tags, expected) {
+		t.Errorf("Expected tags %v, got %v", expected, suggestion.Tags)
+	}
+	if suggestion.Title != "Go worker pools" {
+		t.Errorf("Expected suggested title, got %q", suggestion.Title)
+	}
+	if !strings.Contains(provider.Requests[0].Prompt, `"python"`) {
+		t.Error("Expected the tag vocabulary to be included in the prompt")
+	}
+}
+
+func TestSuggestTagsKeepsCurrentTitle(t *testing.T) {
+	doc := Document{PrimaryKey: "1", Title: "Go worker pools", Content: "..."}
+	provider := &FakeProvider{Response: `{"tags": [], "title": "Go worker pools"}`}
+
+	suggestion, err := SuggestTags(context.Background(), provider, doc, nil)
+	if err != nil {
+		t.Fatalf("SuggestTags returned an error: %v", err)
+	}
+	if suggestion.Title != "" || len(suggestion.Tags) != 0 {
+		t.Errorf("Expected no suggestions, got %+v", suggestion)
+	}
+}
@@ -0,0 +1,70 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const summarizePrompt = `
+You are a helpful assistant that summarizes notes.
+Write a concise summary of the notes below as Markdown bullet points, highlighting decisions,
+open questions and action items. Do not add a heading and do not invent information.
+%s
+The notes are:
+%s
+`
+
+// Summarize asks provider for a Markdown summary of docs. instructions are added to the prompt,
+// e.g. to describe the audience. When the documents do not fit in one prompt each chunk is
+// summarized separately and the partial summaries are then combined.
+func Summarize(ctx context.Context, provider Provider, docs []Document, instructions string, maxPromptTokens int) (string, error) {
+	if len(docs) == 0 {
+		return "", fmt.Errorf("no notes to summarize")
+	}
+	if maxPromptTokens <= 0 {
+		maxPromptTokens = DefaultMaxPromptTokens
+	}
+
+	overhead := EstimateTokens(fmt.Sprintf(summarizePrompt, instructions, ""))
+	chunks, err := chunkDocuments(docs, maxPromptTokens-overhead)
+	if err != nil {
+		return "", err
+	}
+
+	summaries := make([]Document, len(chunks))
+	for i, chunk := range chunks {
+		summary, err := summarizeChunk(ctx, provider, chunk, instructions)
+		if err != nil {
+			return "", err
+		}
+		if len(chunks) == 1 {
+			return summary, nil
+		}
+		summaries[i] = Document{PrimaryKey: fmt.Sprintf("part-%d", i+1), Title: "Partial summary", Content: summary}
+	}
+	if len(summaries) >= len(docs) {
+		// the partial summaries are as large as the notes, so another round would not converge
+		parts := make([]string, len(summaries))
+		for i, summary := range summaries {
+			parts[i] = summary.Content
+		}
+		return strings.Join(parts, "\n"), nil
+	}
+	return Summarize(ctx, provider, summaries, instructions, maxPromptTokens)
+}
+
+func summarizeChunk(ctx context.Context, provider Provider, chunk []Document, instructions string) (string, error) {
+	jsonDataBytes, err := json.Marshal(chunk)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal data: %w", err)
+	}
+	result, err := provider.Generate(ctx, Request{
+		Prompt: fmt.Sprintf(summarizePrompt, instructions, string(jsonDataBytes)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(result), nil
+}
@@ -0,0 +1,53 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	docs := []Document{{PrimaryKey: "1", Title: "Standup", Content: "Blocked on review."}}
+	provider := &FakeProvider{Response: "  - Blocked on review  \n"}
+
+	summary, err := Summarize(context.Background(), provider, docs, "The notes share the tag 'standup'.", 0)
+	if err != nil {
+		t.Fatalf("Summarize returned an error: %v", err)
+	}
+	if summary != "- Blocked on review" {
+		t.Errorf("Unexpected summary %q", summary)
+	}
+	if !strings.Contains(provider.Requests[0].Prompt, "standup") || !strings.Contains(provider.Requests[0].Prompt, "Blocked on review.") {
+		t.Error("Expected the instructions and notes in the prompt")
+	}
+	if provider.Requests[0].JSON {
+		t.Error("Expected a plain text request")
+	}
+}
+
+func TestSummarizeCombinesChunks(t *testing.T) {
+	docs := make([]Document, 20)
+	for i := range docs {
+		docs[i] = Document{PrimaryKey: fmt.Sprintf("note-%d", i), Content: strings.Repeat("content ", 100)}
+	}
+	provider := &FakeProvider{
+		Respond: func(req Request) (string, error) {
+			if strings.Contains(req.Prompt, "Partial summary") {
+				return "- combined", nil
+			}
+			return "- partial", nil
+		},
+	}
+
+	summary, err := Summarize(context.Background(), provider, docs, "", 1000)
+	if err != nil {
+		t.Fatalf("Summarize returned an error: %v", err)
+	}
+	if summary != "- combined" {
+		t.Errorf("Expected the partial summaries to be combined, got %q", summary)
+	}
+	if len(provider.Requests) < 3 {
+		t.Errorf("Expected several chunk requests plus a combining request, got %d", len(provider.Requests))
+	}
+}
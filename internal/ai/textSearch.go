@@ -0,0 +1,59 @@
+package ai
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// RankByText scores docs against query with TF-IDF over their title, tags and content and
+// returns up to limit matches ordered by score. Scores are normalised to the 0-1 range.
+func RankByText(docs []Document, query string, limit int) []SearchResponse {
+	queryTerms := Tokenize(query)
+	if len(queryTerms) == 0 || len(docs) == 0 {
+		return []SearchResponse{}
+	}
+
+	termCounts := make([]map[string]int, len(docs))
+	docFreq := make(map[string]int)
+	for i, doc := range docs {
+		counts := make(map[string]int)
+		for _, token := range Tokenize(doc.Title + " " + strings.Join(doc.Tags, " ") + " " + doc.Content) {
+			counts[token]++
+		}
+		for token := range counts {
+			docFreq[token]++
+		}
+		termCounts[i] = counts
+	}
+
+	results := make([]SearchResponse, 0)
+	maxScore := 0.0
+	for i, doc := range docs {
+		score := 0.0
+		for _, term := range queryTerms {
+			count := termCounts[i][term]
+			if count == 0 {
+				continue
+			}
+			idf := math.Log(1 + float64(len(docs))/float64(docFreq[term]))
+			score += (1 + math.Log(float64(count))) * idf
+		}
+		if score == 0 {
+			continue
+		}
+		maxScore = max(maxScore, score)
+		results = append(results, SearchResponse{PrimaryKey: doc.PrimaryKey, Score: score})
+	}
+
+	for i := range results {
+		results[i].Score /= maxScore
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
@@ -0,0 +1,167 @@
+package ai
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+// VectorIndex stores one embedding per note so that queries can be answered locally by cosine similarity.
+type VectorIndex struct {
+	Embedder string                `json:"embedder"`
+	Entries  map[string]IndexEntry `json:"entries"`
+}
+
+// IndexEntry is the embedding of a single note.
+type IndexEntry struct {
+	Hash   string    `json:"hash"` // hash of the embedded text, used to detect stale entries
+	Vector []float32 `json:"vector"`
+}
+
+// LoadVectorIndex reads the index at path. A missing file, or one built by a different embedder,
+// yields an empty index for embedderName.
+func LoadVectorIndex(path string, embedderName string) (*VectorIndex, error) {
+	index := &VectorIndex{Embedder: embedderName, Entries: make(map[string]IndexEntry)}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vector index: %w", err)
+	}
+
+	var stored VectorIndex
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse vector index: %w", err)
+	}
+	if stored.Embedder != embedderName || stored.Entries == nil {
+		return index, nil
+	}
+	return &stored, nil
+}
+
+// Save writes the index to path, creating the parent directory if needed.
+func (v *VectorIndex) Save(path string) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal vector index: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write vector index: %w", err)
+	}
+	return nil
+}
+
+// Upsert embeds the given notes and stores their vectors, skipping notes whose text has not changed.
+func (v *VectorIndex) Upsert(embedder Embedder, noteList ...notes.Note) error {
+	ids := make([]string, 0, len(noteList))
+	hashes := make([]string, 0, len(noteList))
+	texts := make([]string, 0, len(noteList))
+	for _, note := range noteList {
+		text := embeddingText(note)
+		hash := hashText(text)
+		if entry, ok := v.Entries[note.ID]; ok && entry.Hash == hash {
+			continue
+		}
+		ids = append(ids, note.ID)
+		hashes = append(hashes, hash)
+		texts = append(texts, text)
+	}
+	if len(texts) == 0 {
+		return nil
+	}
+
+	vectors, err := embedder.Embed(texts)
+	if err != nil {
+		return fmt.Errorf("failed to embed notes: %w", err)
+	}
+	if len(vectors) != len(texts) {
+		return fmt.Errorf("embedder returned %d vectors for %d notes", len(vectors), len(texts))
+	}
+	for i, id := range ids {
+		v.Entries[id] = IndexEntry{Hash: hashes[i], Vector: vectors[i]}
+	}
+	return nil
+}
+
+// Sync makes the index match noteList: new and edited notes are embedded and deleted notes are dropped.
+func (v *VectorIndex) Sync(embedder Embedder, noteList []notes.Note) error {
+	live := make(map[string]struct{}, len(noteList))
+	for _, note := range noteList {
+		live[note.ID] = struct{}{}
+	}
+	for id := range v.Entries {
+		if _, ok := live[id]; !ok {
+			delete(v.Entries, id)
+		}
+	}
+	return v.Upsert(embedder, noteList...)
+}
+
+// Remove drops the vector stored for noteID.
+func (v *VectorIndex) Remove(noteID string) {
+	delete(v.Entries, noteID)
+}
+
+// Search embeds query and returns up to limit notes ordered by cosine similarity. When candidates
+// are given, only those note IDs are considered.
+func (v *VectorIndex) Search(embedder Embedder, query string, limit int, candidates ...string) ([]SearchResponse, error) {
+	vectors, err := embedder.Embed([]string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embedder returned %d vectors for 1 query", len(vectors))
+	}
+
+	var allowed map[string]struct{}
+	if candidates != nil {
+		allowed = make(map[string]struct{}, len(candidates))
+		for _, id := range candidates {
+			allowed[id] = struct{}{}
+		}
+	}
+
+	results := make([]SearchResponse, 0, len(v.Entries))
+	for id, entry := range v.Entries {
+		if _, ok := allowed[id]; allowed != nil && !ok {
+			continue
+		}
+		score := CosineSimilarity(vectors[0], entry.Vector)
+		if score <= 0 {
+			continue
+		}
+		results = append(results, SearchResponse{PrimaryKey: id, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score == results[j].Score {
+			return results[i].PrimaryKey < results[j].PrimaryKey
+		}
+		return results[i].Score > results[j].Score
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// embeddingText is the text embedded for a note: its title, tags and content.
+func embeddingText(note notes.Note) string {
+	return note.Title + "\n" + strings.Join(note.Tags, " ") + "\n" + note.Content
+}
+
+func hashText(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
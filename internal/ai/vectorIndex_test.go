@@ -0,0 +1,99 @@
+package ai
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestHashEmbedder(t *testing.T) {
+	embedder := NewHashEmbedder(DefaultHashDimensions)
+	vectors, err := embedder.Embed([]string{"Go concurrency patterns", "go CONCURRENCY patterns!", "baking sourdough bread"})
+	if err != nil {
+		t.Fatalf("Embed returned an error: %v", err)
+	}
+	if len(vectors) != 3 {
+		t.Fatalf("Expected 3 vectors, got %d", len(vectors))
+	}
+	if len(vectors[0]) != DefaultHashDimensions {
+		t.Errorf("Expected %d dimensions, got %d", DefaultHashDimensions, len(vectors[0]))
+	}
+
+	if sim := CosineSimilarity(vectors[0], vectors[1]); sim < 0.999 {
+		t.Errorf("Expected identical token sets to have similarity 1, got %f", sim)
+	}
+	if sim := CosineSimilarity(vectors[0], vectors[2]); sim > 0.5 {
+		t.Errorf("Expected unrelated texts to have low similarity, got %f", sim)
+	}
+}
+
+func TestVectorIndexSearch(t *testing.T) {
+	jsonData, err := os.ReadFile("../notes/testNotes.json")
+	if err != nil {
+		t.Fatalf("Failed to read testNotes.json: %v", err)
+	}
+	var data []notes.Note
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		t.Fatalf("Failed to unmarshal notes from testNotes.json: %v", err)
+	}
+
+	embedder := NewHashEmbedder(DefaultHashDimensions)
+	path := filepath.Join(t.TempDir(), "embeddings.json")
+	index, err := LoadVectorIndex(path, embedder.Name())
+	if err != nil {
+		t.Fatalf("LoadVectorIndex returned an error: %v", err)
+	}
+	if err := index.Sync(embedder, data); err != nil {
+		t.Fatalf("Sync returned an error: %v", err)
+	}
+	if err := index.Save(path); err != nil {
+		t.Fatalf("Save returned an error: %v", err)
+	}
+
+	reloaded, err := LoadVectorIndex(path, embedder.Name())
+	if err != nil {
+		t.Fatalf("LoadVectorIndex returned an error: %v", err)
+	}
+	if len(reloaded.Entries) != len(data) {
+		t.Fatalf("Expected %d entries after reload, got %d", len(data), len(reloaded.Entries))
+	}
+
+	results, err := reloaded.Search(embedder, "go concurrency", 3)
+	if err != nil {
+		t.Fatalf("Search returned an error: %v", err)
+	}
+	if len(results) == 0 || results[0].PrimaryKey != data[5].ID {
+		t.Errorf("Expected 'Go Programming Best Practices' note (ID %s) to rank first, got %v", data[5].ID, results)
+	}
+
+	// candidates restrict the search to a filtered subset of the notes
+	restricted, err := reloaded.Search(embedder, "go concurrency", 3, data[0].ID, data[1].ID)
+	if err != nil {
+		t.Fatalf("Search returned an error: %v", err)
+	}
+	for _, res := range restricted {
+		if res.PrimaryKey != data[0].ID && res.PrimaryKey != data[1].ID {
+			t.Errorf("Expected only candidate notes, got %s", res.PrimaryKey)
+		}
+	}
+
+	// a different embedder must not reuse incompatible vectors
+	other, err := LoadVectorIndex(path, "hash-8")
+	if err != nil {
+		t.Fatalf("LoadVectorIndex returned an error: %v", err)
+	}
+	if len(other.Entries) != 0 {
+		t.Errorf("Expected an empty index for a different embedder, got %d entries", len(other.Entries))
+	}
+
+	// deleted notes are dropped on sync
+	if err := reloaded.Sync(embedder, data[:1]); err != nil {
+		t.Fatalf("Sync returned an error: %v", err)
+	}
+	if len(reloaded.Entries) != 1 {
+		t.Errorf("Expected 1 entry after sync, got %d", len(reloaded.Entries))
+	}
+}
@@ -4,21 +4,83 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config holds the application's configuration settings.
 type Config struct {
-	NotesDirectory string `mapstructure:"notes_directory"`
-	ActiveNote     string `mapstructure:"active_note"`
-	DataDir        string `mapstructure:"data_dir"`       // Directory where notes data will be stored
-	Editor         string `mapstructure:"editor"`         // Preferred text editor for editing notes (e.g., "vim", "nano", "code")
-	NoteID         string `mapstructure:"note_id"`        // The ID of the active note
-	GeminiAPIKey   string `mapstructure:"gemini_api_key"` // API key for Gemini (for semantic search)
+	NotesDirectory string        `mapstructure:"notes_directory"`
+	ActiveNote     string        `mapstructure:"active_note"`
+	DataDir        string        `mapstructure:"data_dir"`             // Directory where notes data will be stored
+	Editor         string        `mapstructure:"editor"`               // Preferred text editor for editing notes (e.g., "vim", "nano", "code")
+	NoteID         string        `mapstructure:"note_id"`              // The ID of the active note
+	GeminiAPIKey   string        `mapstructure:"gemini_api_key"`       // API key for Gemini (for semantic search)
+	AIProvider     string        `mapstructure:"ai_provider"`          // LLM provider: gemini, openai (any OpenAI-compatible endpoint) or ollama
+	AIModel        string        `mapstructure:"ai_model"`             // Model name, defaults to a provider specific model
+	AIBaseURL      string        `mapstructure:"ai_base_url"`          // Endpoint for openai-compatible and ollama providers
+	AIAPIKey       string        `mapstructure:"ai_api_key"`           // API key for the provider (gemini falls back to gemini_api_key)
+	AIMaxTokens    int           `mapstructure:"ai_max_prompt_tokens"` // Token budget for a single semantic search prompt
+	AIConcurrency  int           `mapstructure:"ai_concurrency"`       // Maximum number of concurrent requests to the provider
+	AITimeout      time.Duration `mapstructure:"ai_timeout"`           // Time limit for a single request to the provider, e.g. "60s"
+	AIMaxRetries   *int          `mapstructure:"ai_max_retries"`       // Retries on rate limits, server errors and timeouts; nil (not set) means 3, 0 disables retrying
+	AICacheTTL     time.Duration `mapstructure:"ai_cache_ttl"`         // How long cached semantic search results are reused, e.g. "24h"
+	Embedder       string        `mapstructure:"embedder"`             // Embedding model used for the local vector index ("hash" works offline)
+	TagCaseFold    bool          `mapstructure:"tag_case_fold"`        // Treat tags that differ only in case as the same tag
+	// JournalTitleFormat is the Go time layout for daily note titles, "2006-01-02" by default
+	JournalTitleFormat string `mapstructure:"journal_title_format"`
+	// JournalCarryOver moves open tasks from the previous daily note into a new one
+	JournalCarryOver bool `mapstructure:"journal_carry_over"`
+	// TagAliases maps an alias to the canonical tag it stands for in searches and new taggings
+	TagAliases map[string]string `mapstructure:"tag_aliases"`
+	// SavedSearches maps a search name to the filters saved with 'simple-jot search save'
+	SavedSearches map[string]SearchQuery `mapstructure:"saved_searches"`
 	// Add other configuration fields as your application grows
 }
 
+// SearchQuery holds the filters accepted by the search command.
+type SearchQuery struct {
+	Semantic  string `mapstructure:"semantic"`
+	Vector    string `mapstructure:"vector"`
+	Hybrid    string `mapstructure:"hybrid"`
+	Content   string `mapstructure:"content"`
+	Tag       string `mapstructure:"tag"`
+	ExactTag  bool   `mapstructure:"exact_tag"` // match only the tag itself, not tags below it in the hierarchy
+	Prop      string `mapstructure:"prop"`      // comma-separated key=value (or key) property filters
+	DateStart string `mapstructure:"date_start"`
+	DateEnd   string `mapstructure:"date_end"`
+}
+
+// IsEmpty reports whether no filter is set on the query.
+func (q SearchQuery) IsEmpty() bool {
+	return q == SearchQuery{}
+}
+
+// ToMap returns the non-empty filters keyed by their config names, ready to be written with viper.
+func (q SearchQuery) ToMap() map[string]string {
+	m := make(map[string]string)
+	fields := map[string]string{
+		"semantic":   q.Semantic,
+		"vector":     q.Vector,
+		"hybrid":     q.Hybrid,
+		"content":    q.Content,
+		"tag":        q.Tag,
+		"prop":       q.Prop,
+		"date_start": q.DateStart,
+		"date_end":   q.DateEnd,
+	}
+	for k, v := range fields {
+		if v != "" {
+			m[k] = v
+		}
+	}
+	if q.ExactTag {
+		m["exact_tag"] = "true"
+	}
+	return m
+}
+
 // globalConfig stores the loaded configuration.
 var globalConfig *Config
 
@@ -39,22 +101,24 @@ func InitConfig() error {
 		return fmt.Errorf("failed to get user home directory: %w", err)
 	}
 	viper.AddConfigPath(filepath.Join(home, ".config", "simple-jot")) // Standard XDG config dir
-	viper.AddConfigPath(home)                                       // Fallback to home directory directly
+	viper.AddConfigPath(home)                                         // Fallback to home directory directly
 
 	// Set default values for configuration options
 	defaultDataDir := filepath.Join(home, ".simple-jot", "data")
 	viper.SetDefault("data_dir", defaultDataDir)
 	viper.SetDefault("editor", os.Getenv("EDITOR")) // Use EDITOR env var as default for editor
+	viper.SetDefault("ai_provider", "gemini")
+	viper.SetDefault("embedder", "hash")
 
 	// Read environment variables (e.g., NOTECLI_DATA_DIR, NOTECLI_EDITOR)
 	viper.SetEnvPrefix("SIMPLE_JOT") // Prefix for environment variables (e.g., SIMPLE_JOT_DATA_DIR)
-	viper.AutomaticEnv()          // Read matching environment variables
+	viper.AutomaticEnv()             // Read matching environment variables
 
 	// Attempt to read the config file
 	if err := viper.ReadInConfig(); err != nil {
 		// It's okay if the config file doesn't exist, we'll use defaults or env vars
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			fmt.Println("No config file found, using defaults and environment variables.")
+			fmt.Fprintln(os.Stderr, "No config file found, using defaults and environment variables.")
 		} else {
 			return fmt.Errorf("failed to read config file: %w", err)
 		}
@@ -68,7 +132,7 @@ func InitConfig() error {
 
 	// Ensure the data directory exists
 	if _, err := os.Stat(globalConfig.DataDir); os.IsNotExist(err) {
-		fmt.Printf("Data directory does not exist, creating: %s\n", globalConfig.DataDir)
+		fmt.Fprintf(os.Stderr, "Data directory does not exist, creating: %s\n", globalConfig.DataDir)
 		if err := os.MkdirAll(globalConfig.DataDir, 0755); err != nil {
 			return fmt.Errorf("failed to create data directory %s: %w", globalConfig.DataDir, err)
 		}
@@ -407,4 +407,24 @@ func TestConfigDirectManipulation(t *testing.T) {
 			t.Errorf("Expected ActiveNote 'note-123', got %q", testConfig.ActiveNote)
 		}
 	})
+	t.Run("Unset and zero max retries", func(t *testing.T) {
+		viper.Reset()
+
+		var unset Config
+		if err := viper.Unmarshal(&unset); err != nil {
+			t.Fatalf("Failed to unmarshal config: %v", err)
+		}
+		if unset.AIMaxRetries != nil {
+			t.Errorf("Expected AIMaxRetries to be nil when not set, got %d", *unset.AIMaxRetries)
+		}
+
+		viper.Set("ai_max_retries", 0)
+		var zero Config
+		if err := viper.Unmarshal(&zero); err != nil {
+			t.Fatalf("Failed to unmarshal config: %v", err)
+		}
+		if zero.AIMaxRetries == nil || *zero.AIMaxRetries != 0 {
+			t.Errorf("Expected AIMaxRetries to be 0 when set to 0, got %v", zero.AIMaxRetries)
+		}
+	})
 }
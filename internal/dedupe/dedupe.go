@@ -0,0 +1,232 @@
+package dedupe
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+)
+
+// DefaultThreshold is the estimated Jaccard similarity above which notes count as near duplicates.
+const DefaultThreshold = 0.8
+
+// Cluster is a group of notes that are exact or near duplicates of each other.
+type Cluster struct {
+	Notes      []notes.Note
+	Similarity float64 // lowest estimated similarity between linked notes in the cluster
+	Exact      bool    // every note has the same normalised title and content
+}
+
+// FindClusters groups noteList into clusters of duplicates whose estimated similarity is at least
+// threshold. Candidate pairs are found with MinHash locality sensitive hashing, so stores with
+// thousands of notes do not need every pair to be compared. Clusters are ordered by their oldest note.
+func FindClusters(noteList []notes.Note, threshold float64) []Cluster {
+	parent := make([]int, len(noteList))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	minSim := make(map[int]float64)
+	union := func(a, b int, sim float64) {
+		ra, rb := find(a), find(b)
+		simA, okA := minSim[ra]
+		simB, okB := minSim[rb]
+		if ra != rb {
+			parent[rb] = ra
+		}
+		merged := sim
+		if okA {
+			merged = min(merged, simA)
+		}
+		if okB {
+			merged = min(merged, simB)
+		}
+		delete(minSim, rb)
+		minSim[ra] = merged
+	}
+
+	// exact duplicates share a fingerprint of their normalised text
+	fingerprints := make(map[string]int)
+	signatures := make([]Signature, len(noteList))
+	buckets := make(map[uint64][]int)
+	for i, n := range noteList {
+		text := normalise(n.Title) + "\n" + normalise(n.Content)
+		sum := sha256.Sum256([]byte(text))
+		fp := string(sum[:])
+		if first, ok := fingerprints[fp]; ok {
+			union(first, i, 1)
+		} else {
+			fingerprints[fp] = i
+		}
+
+		signatures[i] = MinHash(Shingles(text, ShingleSize))
+		for _, key := range signatures[i].bandKeys() {
+			buckets[key] = append(buckets[key], i)
+		}
+	}
+
+	checked := make(map[[2]int]struct{})
+	for _, members := range buckets {
+		for a := 0; a < len(members); a++ {
+			for b := a + 1; b < len(members); b++ {
+				pair := [2]int{members[a], members[b]}
+				if _, ok := checked[pair]; ok {
+					continue
+				}
+				checked[pair] = struct{}{}
+				if sim := signatures[pair[0]].Similarity(signatures[pair[1]]); sim >= threshold {
+					union(pair[0], pair[1], sim)
+				}
+			}
+		}
+	}
+
+	grouped := make(map[int][]int)
+	for i := range noteList {
+		root := find(i)
+		grouped[root] = append(grouped[root], i)
+	}
+
+	clusters := make([]Cluster, 0)
+	for root, members := range grouped {
+		if len(members) < 2 {
+			continue
+		}
+		cluster := Cluster{Similarity: minSim[root], Exact: true}
+		fp := ""
+		for _, i := range members {
+			n := noteList[i]
+			cluster.Notes = append(cluster.Notes, n)
+			text := normalise(n.Title) + "\n" + normalise(n.Content)
+			if fp == "" {
+				fp = text
+			} else if text != fp {
+				cluster.Exact = false
+			}
+		}
+		sortByCreated(cluster.Notes)
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Notes[0].CreatedAt < clusters[j].Notes[0].CreatedAt
+	})
+	return clusters
+}
+
+// Merge strategies for the content of a merged note.
+const (
+	StrategyConcat  = "concat"  // join every distinct content, oldest first
+	StrategyLongest = "longest" // keep the longest content
+	StrategyFirst   = "first"   // keep the content of the oldest note
+	StrategyLatest  = "latest"  // keep the content of the most recently updated note
+)
+
+// Strategies lists the supported merge strategies.
+var Strategies = []string{StrategyConcat, StrategyLongest, StrategyFirst, StrategyLatest}
+
+// contentSeparator separates the contents of notes merged with StrategyConcat.
+const contentSeparator = "\n\n---\n\n"
+
+// Merge combines the notes of a cluster into one note. The result keeps the ID, title and
+// CreatedAt of the oldest note, the union of all tags and properties (the oldest note wins when a
+// property is set more than once), the earliest due date, every pinned, favorite and archived
+// state, and content chosen by strategy.
+func Merge(cluster []notes.Note, strategy string) (notes.Note, error) {
+	if len(cluster) == 0 {
+		return notes.Note{}, fmt.Errorf("cannot merge an empty cluster")
+	}
+	sorted := slices.Clone(cluster)
+	sortByCreated(sorted)
+
+	merged := sorted[0]
+	merged.Tags = []string{}
+	merged.Properties = nil
+	var earliestDue timeutils.Due
+	for _, n := range sorted {
+		for _, tag := range n.Tags {
+			if !slices.Contains(merged.Tags, tag) {
+				merged.Tags = append(merged.Tags, tag)
+			}
+		}
+		for key, value := range n.Properties {
+			if _, ok := merged.Properties[key]; !ok {
+				if merged.Properties == nil {
+					merged.Properties = make(map[string]string)
+				}
+				merged.Properties[key] = value
+			}
+		}
+		if due, err := timeutils.ParseDueValue(n.Due); err == nil && !due.IsZero() &&
+			(earliestDue.IsZero() || due.Time.Before(earliestDue.Time)) {
+			earliestDue = due
+		}
+		merged.Pinned = merged.Pinned || n.Pinned
+		merged.Favorite = merged.Favorite || n.Favorite
+		merged.Archived = merged.Archived || n.Archived
+	}
+	merged.Due = earliestDue.String()
+
+	switch strategy {
+	case StrategyConcat:
+		contents := make([]string, 0, len(sorted))
+		seen := make(map[string]struct{})
+		for _, n := range sorted {
+			key := normalise(n.Content)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			contents = append(contents, strings.TrimRight(n.Content, "\n"))
+		}
+		merged.Content = strings.Join(contents, contentSeparator)
+	case StrategyLongest:
+		for _, n := range sorted {
+			if len(n.Content) > len(merged.Content) {
+				merged.Content = n.Content
+			}
+		}
+	case StrategyFirst:
+		// the oldest note's content is already in place
+	case StrategyLatest:
+		latest := sorted[0]
+		for _, n := range sorted {
+			if n.UpdatedAt > latest.UpdatedAt {
+				latest = n
+			}
+		}
+		merged.Content = latest.Content
+	default:
+		return notes.Note{}, fmt.Errorf("unknown merge strategy '%s'. Supported strategies: %s", strategy, strings.Join(Strategies, ", "))
+	}
+
+	merged.UpdatedAt = time.Now().Format(time.DateTime)
+	return merged, nil
+}
+
+// normalise lower-cases text and collapses whitespace so formatting differences do not matter.
+func normalise(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// sortByCreated orders notes by CreatedAt, oldest first. Unparseable timestamps sort last.
+func sortByCreated(noteList []notes.Note) {
+	sort.SliceStable(noteList, func(i, j int) bool {
+		ti, errI := timeutils.ParseNoteTime(noteList[i].CreatedAt)
+		tj, errJ := timeutils.ParseNoteTime(noteList[j].CreatedAt)
+		if errI != nil || errJ != nil {
+			return errI == nil && errJ != nil
+		}
+		return ti.Before(tj)
+	})
+}
@@ -0,0 +1,114 @@
+package dedupe
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestFindClusters(t *testing.T) {
+	meeting := "Met with the platform team to review the migration plan for the billing service and agreed on a rollout date in March with a staged canary deployment."
+	noteList := []notes.Note{
+		{ID: "a", Title: "Billing migration", Content: meeting, CreatedAt: "2025-01-02 10:00:00"},
+		{ID: "b", Title: "billing  migration", Content: strings.ToUpper(meeting), CreatedAt: "2025-01-01 10:00:00"},
+		{ID: "c", Title: "Billing migration", Content: meeting + " Follow up next week.", CreatedAt: "2025-01-03 10:00:00"},
+		{ID: "d", Title: "Groceries", Content: "Eggs, milk, bread and coffee beans.", CreatedAt: "2025-01-01 09:00:00"},
+	}
+	for i := range 50 {
+		noteList = append(noteList, notes.Note{
+			ID:        fmt.Sprintf("filler-%d", i),
+			Title:     fmt.Sprintf("Filler %d", i),
+			Content:   fmt.Sprintf("Unrelated note number %d about topic %d with its own words %d.", i, i*7, i*13),
+			CreatedAt: "2025-02-01 10:00:00",
+		})
+	}
+
+	clusters := FindClusters(noteList, DefaultThreshold)
+	if len(clusters) != 1 {
+		t.Fatalf("Expected 1 cluster, got %d", len(clusters))
+	}
+	ids := make([]string, 0)
+	for _, n := range clusters[0].Notes {
+		ids = append(ids, n.ID)
+	}
+	if !slices.Equal(ids, []string{"b", "a", "c"}) {
+		t.Errorf("Expected cluster [b a c] ordered oldest first, got %v", ids)
+	}
+	if clusters[0].Exact {
+		t.Error("Expected the cluster not to be exact")
+	}
+	if clusters[0].Similarity < DefaultThreshold || clusters[0].Similarity > 1 {
+		t.Errorf("Expected similarity in [%v, 1], got %v", DefaultThreshold, clusters[0].Similarity)
+	}
+
+	exact := FindClusters(noteList[:2], DefaultThreshold)
+	if len(exact) != 1 || !exact[0].Exact || exact[0].Similarity != 1 {
+		t.Errorf("Expected one exact cluster, got %+v", exact)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	cluster := []notes.Note{
+		{ID: "new", Title: "Newer", Tags: []string{"b", "c"}, Content: "longer content here", CreatedAt: "2025-01-02 10:00:00", UpdatedAt: "2025-01-05 10:00:00"},
+		{ID: "old", Title: "Older", Tags: []string{"a", "b"}, Content: "short", CreatedAt: "2025-01-01 10:00:00", UpdatedAt: "2025-01-01 10:00:00"},
+	}
+
+	tests := map[string]string{
+		StrategyConcat:  "short" + contentSeparator + "longer content here",
+		StrategyLongest: "longer content here",
+		StrategyFirst:   "short",
+		StrategyLatest:  "longer content here",
+	}
+	for strategy, want := range tests {
+		merged, err := Merge(cluster, strategy)
+		if err != nil {
+			t.Fatalf("Merge(%s) returned an error: %v", strategy, err)
+		}
+		if merged.ID != "old" || merged.Title != "Older" || merged.CreatedAt != "2025-01-01 10:00:00" {
+			t.Errorf("Merge(%s) should keep the oldest note's identity, got %+v", strategy, merged)
+		}
+		if !slices.Equal(merged.Tags, []string{"a", "b", "c"}) {
+			t.Errorf("Merge(%s) expected tags [a b c], got %v", strategy, merged.Tags)
+		}
+		if merged.Content != want {
+			t.Errorf("Merge(%s) expected content %q, got %q", strategy, want, merged.Content)
+		}
+	}
+
+	if _, err := Merge(cluster, "bogus"); err == nil {
+		t.Error("Expected an error for an unknown strategy")
+	}
+	if _, err := Merge(nil, StrategyFirst); err == nil {
+		t.Error("Expected an error for an empty cluster")
+	}
+}
+
+func TestMergeKeepsMetadata(t *testing.T) {
+	cluster := []notes.Note{
+		{ID: "new", Content: "a", CreatedAt: "2025-01-02 10:00:00", Properties: map[string]string{"status": "done", "owner": "kim"},
+			Due: "2025-03-01 09:00", Pinned: true, Archived: true},
+		{ID: "old", Content: "a", CreatedAt: "2025-01-01 10:00:00", Properties: map[string]string{"status": "open"}, Due: "2025-04-01"},
+		{ID: "mid", Content: "a", CreatedAt: "2025-01-01 12:00:00", Due: "2025-02-01", Favorite: true},
+	}
+
+	merged, err := Merge(cluster, StrategyFirst)
+	if err != nil {
+		t.Fatalf("Merge returned an error: %v", err)
+	}
+	if expected := map[string]string{"status": "open", "owner": "kim"}; !maps.Equal(merged.Properties, expected) {
+		t.Errorf("Expected properties %v, got %v", expected, merged.Properties)
+	}
+	if merged.Due != "2025-02-01" {
+		t.Errorf("Expected the earliest due date 2025-02-01, got %q", merged.Due)
+	}
+	if !merged.Pinned || !merged.Favorite || !merged.Archived {
+		t.Errorf("Expected every state to be kept, got %+v", merged)
+	}
+	if _, ok := cluster[1].Properties["owner"]; ok {
+		t.Error("Expected Merge not to modify the properties of the oldest note")
+	}
+}
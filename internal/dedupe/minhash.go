@@ -0,0 +1,121 @@
+package dedupe
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// Defaults for the MinHash signatures. With 32 bands of 4 rows, pairs with a Jaccard similarity
+// around 0.5 and above are very likely to become candidates.
+const (
+	ShingleSize   = 3
+	NumHashes     = 128
+	BandRows      = 4
+	mersennePrime = (1 << 61) - 1
+)
+
+// Signature is the MinHash signature of a set of shingles.
+type Signature []uint64
+
+// Shingles splits text into overlapping sequences of size words, hashed to 64 bits. Text shorter
+// than size words yields a single shingle of all its words.
+func Shingles(text string, size int) map[uint64]struct{} {
+	words := strings.Fields(strings.ToLower(text))
+	shingles := make(map[uint64]struct{})
+	if len(words) == 0 {
+		return shingles
+	}
+	if len(words) < size {
+		size = len(words)
+	}
+	for i := 0; i+size <= len(words); i++ {
+		hasher := fnv.New64a()
+		hasher.Write([]byte(strings.Join(words[i:i+size], " ")))
+		shingles[hasher.Sum64()] = struct{}{}
+	}
+	return shingles
+}
+
+// hashParams holds the coefficients of the universal hash functions (a*x + b) mod p. They are
+// derived from a fixed seed so signatures are stable between runs.
+var hashParams = func() [][2]uint64 {
+	params := make([][2]uint64, NumHashes)
+	state := uint64(0x9E3779B97F4A7C15)
+	next := func() uint64 {
+		// splitmix64
+		state += 0x9E3779B97F4A7C15
+		z := state
+		z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+		z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+		return z ^ (z >> 31)
+	}
+	for i := range params {
+		params[i] = [2]uint64{next()%(mersennePrime-1) + 1, next() % mersennePrime}
+	}
+	return params
+}()
+
+// MinHash computes the signature of a shingle set.
+func MinHash(shingles map[uint64]struct{}) Signature {
+	sig := make(Signature, NumHashes)
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	for shingle := range shingles {
+		x := shingle % mersennePrime
+		for i, p := range hashParams {
+			h := mulMod(p[0], x) + p[1]
+			h %= mersennePrime
+			if h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+// Similarity estimates the Jaccard similarity of the sets behind two signatures.
+func (s Signature) Similarity(other Signature) float64 {
+	if len(s) == 0 || len(s) != len(other) {
+		return 0
+	}
+	equal := 0
+	for i := range s {
+		if s[i] == other[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(s))
+}
+
+// bandKeys splits a signature into bands and hashes each one, for locality sensitive hashing.
+func (s Signature) bandKeys() []uint64 {
+	keys := make([]uint64, 0, len(s)/BandRows)
+	for start := 0; start+BandRows <= len(s); start += BandRows {
+		hasher := fnv.New64a()
+		buf := make([]byte, 8)
+		for _, v := range s[start : start+BandRows] {
+			for b := range buf {
+				buf[b] = byte(v >> (8 * b))
+			}
+			hasher.Write(buf)
+		}
+		keys = append(keys, hasher.Sum64()^uint64(start))
+	}
+	return keys
+}
+
+// mulMod returns (a * b) mod 2^61-1 without overflowing.
+func mulMod(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	// split the 128-bit product at bit 61 and fold the high part back in
+	low61 := lo & mersennePrime
+	high := (lo >> 61) | (hi << 3)
+	r := low61 + high
+	for r >= mersennePrime {
+		r -= mersennePrime
+	}
+	return r
+}
@@ -0,0 +1,124 @@
+package journal
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/tasks"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+)
+
+// Tag marks a note as a daily journal note.
+const Tag = "journal"
+
+// DefaultTitleFormat is the time layout used for daily note titles when none is configured.
+const DefaultTitleFormat = "2006-01-02"
+
+// Journal finds daily notes by their title, which is the day formatted with TitleFormat.
+type Journal struct {
+	TitleFormat string // Go time layout, e.g. "2006-01-02" or "Monday 2 Jan 2006"
+}
+
+// Title returns the title of the daily note for day.
+func (j Journal) Title(day time.Time) string {
+	return day.Format(j.TitleFormat)
+}
+
+// Day returns the date a daily note is for. ok is false when the note is not a journal note or
+// its title does not match the title format.
+func (j Journal) Day(n notes.Note) (day time.Time, ok bool) {
+	if !slices.Contains(n.Tags, Tag) {
+		return time.Time{}, false
+	}
+	day, err := time.ParseInLocation(j.TitleFormat, n.Title, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return timeutils.StartOfDay(day), true
+}
+
+// Find returns the index of the daily note for day in noteList, or -1 if there is none.
+func (j Journal) Find(noteList []notes.Note, day time.Time) int {
+	title := j.Title(day)
+	return slices.IndexFunc(noteList, func(n notes.Note) bool {
+		return n.Title == title && slices.Contains(n.Tags, Tag)
+	})
+}
+
+// Previous returns the index of the latest daily note before day, or -1 if there is none.
+func (j Journal) Previous(noteList []notes.Note, day time.Time) int {
+	day = timeutils.StartOfDay(day)
+	found := -1
+	var foundDay time.Time
+	for i, n := range noteList {
+		d, ok := j.Day(n)
+		if ok && d.Before(day) && (found == -1 || d.After(foundDay)) {
+			found, foundDay = i, d
+		}
+	}
+	return found
+}
+
+// Between returns the daily notes from the day of start to the day of end inclusive, oldest first.
+func (j Journal) Between(noteList []notes.Note, start time.Time, end time.Time) []notes.Note {
+	start, end = timeutils.StartOfDay(start), timeutils.StartOfDay(end)
+	type dailyNote struct {
+		day  time.Time
+		note notes.Note
+	}
+	found := make([]dailyNote, 0)
+	for _, n := range noteList {
+		if d, ok := j.Day(n); ok && !d.Before(start) && !d.After(end) {
+			found = append(found, dailyNote{d, n})
+		}
+	}
+	slices.SortStableFunc(found, func(a, b dailyNote) int { return a.day.Compare(b.day) })
+
+	dailyNotes := make([]notes.Note, 0, len(found))
+	for _, f := range found {
+		dailyNotes = append(dailyNotes, f.note)
+	}
+	return dailyNotes
+}
+
+// Week returns the Monday and Sunday of the week containing day.
+func Week(day time.Time) (time.Time, time.Time) {
+	day = timeutils.StartOfDay(day)
+	monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	return monday, monday.AddDate(0, 0, 6)
+}
+
+// Entry returns text as a journal line stamped with the time of day of now.
+func Entry(now time.Time, text string) string {
+	return "- " + now.Format("15:04") + " " + strings.TrimSpace(text)
+}
+
+// Append adds line to the end of content on a line of its own.
+func Append(content string, line string) string {
+	content = strings.TrimRight(content, "\n")
+	if content == "" {
+		return line
+	}
+	return content + "\n" + line
+}
+
+// CarryOver returns the open task lines of content, unindented and in order, so they can be copied
+// to the daily note titled to. Due dates in the task text are kept. It also returns content with
+// those tasks marked as carried with tasks.SetCarried, so each task stays open in one note only
+// without being recorded as done.
+func CarryOver(content string, to string) ([]string, string) {
+	lines := strings.Split(content, "\n")
+	open := make([]string, 0)
+	updated := content
+	for _, task := range tasks.Parse(content) {
+		if task.Done {
+			continue
+		}
+		open = append(open, strings.TrimSpace(lines[task.Line-1]))
+		// the line is a task found by Parse, so this cannot fail
+		updated, _ = tasks.SetCarried(updated, task.Line, to)
+	}
+	return open, updated
+}
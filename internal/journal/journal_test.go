@@ -0,0 +1,84 @@
+package journal
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/tasks"
+)
+
+func TestJournalFindAndPrevious(t *testing.T) {
+	j := Journal{TitleFormat: DefaultTitleFormat}
+	noteList := []notes.Note{
+		{ID: "a", Title: "2025-06-27", Tags: []string{Tag}},
+		{ID: "b", Title: "2025-06-30", Tags: []string{Tag}},
+		{ID: "c", Title: "2025-07-01", Tags: []string{"work"}},
+		{ID: "d", Title: "not a date", Tags: []string{Tag}},
+		{ID: "e", Title: "2025-07-01", Tags: []string{Tag}},
+	}
+	day := time.Date(2025, 7, 1, 15, 30, 0, 0, time.Local)
+
+	if idx := j.Find(noteList, day); idx != 4 {
+		t.Errorf("Expected the journal note e for %s, got index %d", day, idx)
+	}
+	if idx := j.Previous(noteList, day); idx != 1 {
+		t.Errorf("Expected the previous daily note b, got index %d", idx)
+	}
+	if idx := j.Previous(noteList, day.AddDate(0, 0, -7)); idx != -1 {
+		t.Errorf("Expected no daily note before %s, got index %d", day.AddDate(0, 0, -7), idx)
+	}
+}
+
+func TestJournalBetweenWeek(t *testing.T) {
+	j := Journal{TitleFormat: "Mon 2 Jan 2006"}
+	noteList := []notes.Note{
+		{ID: "sun", Title: "Sun 6 Jul 2025", Tags: []string{Tag}},
+		{ID: "mon", Title: "Mon 30 Jun 2025", Tags: []string{Tag}},
+		{ID: "prev", Title: "Sun 29 Jun 2025", Tags: []string{Tag}},
+		{ID: "wed", Title: "Wed 2 Jul 2025", Tags: []string{Tag}},
+	}
+
+	start, end := Week(time.Date(2025, 7, 2, 9, 0, 0, 0, time.Local))
+	if start.Format(time.DateOnly) != "2025-06-30" || end.Format(time.DateOnly) != "2025-07-06" {
+		t.Fatalf("Expected the week 2025-06-30 to 2025-07-06, got %s to %s", start, end)
+	}
+
+	ids := make([]string, 0)
+	for _, n := range j.Between(noteList, start, end) {
+		ids = append(ids, n.ID)
+	}
+	if expected := []string{"mon", "wed", "sun"}; !slices.Equal(ids, expected) {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+}
+
+func TestCarryOver(t *testing.T) {
+	content := "# Plan\n- [ ] write docs due:2025-07-02\n- [x] ship\n  - [ ] nested\n```\n- [ ] in code\n```\n"
+	expected := []string{"- [ ] write docs due:2025-07-02", "- [ ] nested"}
+	open, updated := CarryOver(content, "2025-07-02")
+	if !slices.Equal(open, expected) {
+		t.Errorf("Expected %v, got %v", expected, open)
+	}
+	expectedContent := "# Plan\n- [>] write docs due:2025-07-02 (carried to 2025-07-02)\n- [x] ship\n" +
+		"  - [>] nested (carried to 2025-07-02)\n```\n- [ ] in code\n```\n"
+	if updated != expectedContent {
+		t.Errorf("Expected content %q, got %q", expectedContent, updated)
+	}
+
+	// carried tasks are neither open nor done in the old note
+	remaining := tasks.Parse(updated)
+	if len(remaining) != 1 || remaining[0].Text != "ship" || !remaining[0].Done {
+		t.Errorf("Expected only the done task to remain in the old note, got %+v", remaining)
+	}
+}
+
+func TestEntryAppend(t *testing.T) {
+	now := time.Date(2025, 7, 1, 9, 5, 0, 0, time.Local)
+	content := Append("", Entry(now, " standup "))
+	content = Append(content+"\n\n", Entry(now, "lunch"))
+	if expected := "- 09:05 standup\n- 09:05 lunch"; content != expected {
+		t.Errorf("Expected %q, got %q", expected, content)
+	}
+}
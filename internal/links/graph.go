@@ -0,0 +1,71 @@
+package links
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+// Graph is the note graph: every note is a node and every resolved link an edge.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// Node is a note in the graph.
+type Node struct {
+	ID    string   `json:"id"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
+}
+
+// Edge is a link from one note to another. Several links between the same notes become one edge.
+type Edge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// BuildGraph builds the graph of noteList from its link index. Broken links are left out.
+func BuildGraph(noteList []notes.Note, index *Index) Graph {
+	graph := Graph{Nodes: make([]Node, 0, len(noteList)), Edges: make([]Edge, 0)}
+	seen := make(map[Edge]struct{})
+	for _, n := range noteList {
+		graph.Nodes = append(graph.Nodes, Node{ID: n.ID, Title: n.Title, Tags: n.Tags})
+		for _, link := range index.Outgoing[n.ID] {
+			if link.Broken() {
+				continue
+			}
+			edge := Edge{Source: link.Source, Target: link.Target}
+			if _, ok := seen[edge]; ok {
+				continue
+			}
+			seen[edge] = struct{}{}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	return graph
+}
+
+// DOT renders the graph in the Graphviz DOT language, with note titles as node labels.
+func (g Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph notes {\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(node.ID), dotQuote(node.Title))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(edge.Source), dotQuote(edge.Target))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote quotes s as a DOT string.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,110 @@
+package links
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+// wikiLinkPattern matches [[target]] and [[target|label]].
+var wikiLinkPattern = regexp.MustCompile(`\[\[([^\[\]|\n]+)(?:\|([^\[\]\n]*))?\]\]`)
+
+// Link is a [[...]] reference from one note to another.
+type Link struct {
+	Source string // ID of the note containing the link
+	Target string // ID of the linked note, empty when the link is broken
+	Ref    string // text between the brackets, without the label
+	Label  string // optional text after '|'
+	Reason string // why a broken link does not resolve
+}
+
+// Broken reports whether the link does not resolve to exactly one note.
+func (l Link) Broken() bool {
+	return l.Target == ""
+}
+
+// Parse returns the references of every wiki link in content, in order. Links inside fenced
+// code blocks and inline code are ignored.
+func Parse(content string) []Link {
+	found := make([]Link, 0)
+	inFence := false
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, m := range wikiLinkPattern.FindAllStringSubmatchIndex(line, -1) {
+			if strings.Count(line[:m[0]], "`")%2 == 1 {
+				continue
+			}
+			link := Link{Ref: strings.TrimSpace(line[m[2]:m[3]])}
+			if m[4] != -1 {
+				link.Label = strings.TrimSpace(line[m[4]:m[5]])
+			}
+			if link.Ref != "" {
+				found = append(found, link)
+			}
+		}
+	}
+	return found
+}
+
+// Index holds the links between a set of notes in both directions.
+type Index struct {
+	Outgoing map[string][]Link // links found in each note, keyed by source ID
+	Incoming map[string][]Link // resolved links pointing at each note, keyed by target ID
+}
+
+// BuildIndex parses every note in noteList and resolves its links by ID, unique ID prefix,
+// title or slug, the same way note arguments are resolved on the command line.
+func BuildIndex(noteList []notes.Note) *Index {
+	index := &Index{
+		Outgoing: make(map[string][]Link),
+		Incoming: make(map[string][]Link),
+	}
+	for _, n := range noteList {
+		for _, link := range Parse(n.Content) {
+			link.Source = n.ID
+			idx, err := notes.Resolve(noteList, link.Ref, "")
+			var ambiguous *notes.AmbiguousRefError
+			switch {
+			case err == nil:
+				link.Target = noteList[idx].ID
+			case errors.As(err, &ambiguous):
+				link.Reason = "ambiguous"
+			default:
+				link.Reason = "not found"
+			}
+			index.Outgoing[n.ID] = append(index.Outgoing[n.ID], link)
+			if !link.Broken() {
+				index.Incoming[link.Target] = append(index.Incoming[link.Target], link)
+			}
+		}
+	}
+	return index
+}
+
+// Broken returns every link that does not resolve, ordered by source note and position.
+func (i *Index) Broken() []Link {
+	sources := make([]string, 0, len(i.Outgoing))
+	for source := range i.Outgoing {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	broken := make([]Link, 0)
+	for _, source := range sources {
+		for _, link := range i.Outgoing[source] {
+			if link.Broken() {
+				broken = append(broken, link)
+			}
+		}
+	}
+	return broken
+}
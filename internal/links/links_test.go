@@ -0,0 +1,55 @@
+package links
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestParse(t *testing.T) {
+	content := "See [[Design Doc]] and [[3f2a|the ADR]].\n```\n[[in code]]\n```\nAlso `[[inline]]` and [[ ]] and [[design-doc]]."
+	found := Parse(content)
+
+	refs := make([]string, len(found))
+	for i, link := range found {
+		refs[i] = link.Ref
+	}
+	if strings.Join(refs, ",") != "Design Doc,3f2a,design-doc" {
+		t.Errorf("Expected [Design Doc 3f2a design-doc], got %v", refs)
+	}
+	if found[1].Label != "the ADR" {
+		t.Errorf("Expected the label to be parsed, got %q", found[1].Label)
+	}
+}
+
+func TestBuildIndex(t *testing.T) {
+	noteList := []notes.Note{
+		{ID: "3f2a0000-0000-4000-8000-000000000001", Title: "ADR 1", Content: "Follows [[Design Doc]]."},
+		{ID: "9e1c0000-0000-4000-8000-000000000002", Title: "Design Doc", Content: "Decided in [[3f2a]], see [[Missing]] and [[dup]]."},
+		{ID: "aaaa0000-0000-4000-8000-000000000003", Title: "Dup", Content: "[[design-doc]] [[Design Doc]]"},
+		{ID: "bbbb0000-0000-4000-8000-000000000004", Title: "dup"},
+	}
+	index := BuildIndex(noteList)
+
+	if got := len(index.Incoming[noteList[1].ID]); got != 3 {
+		t.Errorf("Expected 3 backlinks to the design doc, got %d", got)
+	}
+	if got := index.Incoming[noteList[0].ID]; len(got) != 1 || got[0].Source != noteList[1].ID {
+		t.Errorf("Expected the prefix link to resolve to ADR 1, got %v", got)
+	}
+
+	broken := index.Broken()
+	if len(broken) != 2 || broken[0].Ref != "Missing" || broken[0].Reason != "not found" || broken[1].Reason != "ambiguous" {
+		t.Errorf("Expected a missing and an ambiguous link, got %+v", broken)
+	}
+
+	graph := BuildGraph(noteList, index)
+	if len(graph.Nodes) != 4 || len(graph.Edges) != 3 {
+		t.Errorf("Expected 4 nodes and 3 deduplicated edges, got %d and %d", len(graph.Nodes), len(graph.Edges))
+	}
+	dot := graph.DOT()
+	if !strings.Contains(dot, `"aaaa0000-0000-4000-8000-000000000003" -> "9e1c0000-0000-4000-8000-000000000002";`) {
+		t.Errorf("Expected the DOT output to contain the edge, got:\n%s", dot)
+	}
+}
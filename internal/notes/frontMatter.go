@@ -0,0 +1,111 @@
+package notes
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+)
+
+// frontMatterDelimiter opens and closes the front matter block of an edited note.
+const frontMatterDelimiter = "---"
+
+// FrontMatter is the editable part of a note: its title, tags, due date, properties and body.
+type FrontMatter struct {
+	Title      string
+	Tags       []string
+	Due        string // as stored in Note.Due, empty when there is no due date
+	Properties map[string]string
+	Content    string
+}
+
+// FormatFrontMatter renders a note for editing as a front matter block with the title, tags, due
+// date and one line per property, followed by the content.
+func FormatFrontMatter(fm FrontMatter) string {
+	var b strings.Builder
+	b.WriteString(frontMatterDelimiter + "\n")
+	fmt.Fprintf(&b, "title: %s\n", fm.Title)
+	fmt.Fprintf(&b, "tags: [%s]\n", strings.Join(fm.Tags, ", "))
+	fmt.Fprintf(&b, "due: %s\n", fm.Due)
+	for _, key := range slices.Sorted(maps.Keys(fm.Properties)) {
+		fmt.Fprintf(&b, "%s: %s\n", key, fm.Properties[key])
+	}
+	b.WriteString(frontMatterDelimiter + "\n")
+	b.WriteString(fm.Content)
+	return b.String()
+}
+
+// ParseFrontMatter reads back text written by FormatFrontMatter. Tags may be written as
+// "[a, b]" or "a, b", the due date in any form timeutils.ParseDue accepts, and any other field is a
+// property; a property left empty is dropped. Text without a front matter block is treated as
+// content only.
+func ParseFrontMatter(text string) (FrontMatter, error) {
+	fm := FrontMatter{Tags: []string{}}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if !strings.HasPrefix(text, frontMatterDelimiter+"\n") {
+		fm.Content = text
+		return fm, nil
+	}
+
+	rest := strings.TrimPrefix(text, frontMatterDelimiter+"\n")
+	header, content, found := strings.Cut(rest, "\n"+frontMatterDelimiter+"\n")
+	if !found {
+		// the closing delimiter may be the last line of the file
+		if h, ok := strings.CutSuffix(rest, "\n"+frontMatterDelimiter); ok {
+			header, content, found = h, "", true
+		} else if h, ok := strings.CutPrefix(rest, frontMatterDelimiter+"\n"); ok {
+			header, content, found = "", h, true
+		}
+	}
+	if !found {
+		return fm, fmt.Errorf("front matter is not closed with '%s'", frontMatterDelimiter)
+	}
+	fm.Content = content
+
+	for i, line := range strings.Split(header, "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return fm, fmt.Errorf("invalid front matter on line %d: %q", i+2, line)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "title":
+			fm.Title = value
+		case "due":
+			if value == "" {
+				continue
+			}
+			due, err := timeutils.ParseDue(value, time.Now())
+			if err != nil {
+				return fm, fmt.Errorf("invalid front matter on line %d: %w", i+2, err)
+			}
+			fm.Due = due.String()
+		case "tags":
+			value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+			for _, tag := range strings.Split(value, ",") {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					fm.Tags = append(fm.Tags, tag)
+				}
+			}
+		default:
+			key = strings.TrimSpace(key)
+			if err := ValidatePropertyKey(key); err != nil {
+				return fm, fmt.Errorf("invalid front matter on line %d: %w", i+2, err)
+			}
+			if value == "" {
+				continue
+			}
+			if fm.Properties == nil {
+				fm.Properties = make(map[string]string)
+			}
+			fm.Properties[key] = value
+		}
+	}
+	return fm, nil
+}
@@ -0,0 +1,95 @@
+package notes
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestFrontMatterRoundTrip(t *testing.T) {
+	original := FrontMatter{
+		Title:      "Release: v2",
+		Tags:       []string{"release", "ops"},
+		Due:        "2025-07-01 14:00",
+		Properties: map[string]string{"status": "in review", "ticket": "OPS-12"},
+		Content:    "## Steps\n\n---\n\n- ship it\n",
+	}
+
+	parsed, err := ParseFrontMatter(FormatFrontMatter(original))
+	if err != nil {
+		t.Fatalf("ParseFrontMatter returned an error: %v", err)
+	}
+	if parsed.Title != original.Title || parsed.Due != original.Due || parsed.Content != original.Content || !slices.Equal(parsed.Tags, original.Tags) ||
+		!maps.Equal(parsed.Properties, original.Properties) {
+		t.Errorf("Expected %+v after round trip, got %+v", original, parsed)
+	}
+}
+
+func TestParseFrontMatter(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    FrontMatter
+		wantErr bool
+	}{
+		{
+			name: "tags without brackets",
+			text: "---\ntitle: Notes\ntags: a, b\n---\nbody",
+			want: FrontMatter{Title: "Notes", Tags: []string{"a", "b"}, Content: "body"},
+		},
+		{
+			name: "empty tags and body",
+			text: "---\ntitle: Empty\ntags: []\n---\n",
+			want: FrontMatter{Title: "Empty", Tags: []string{}},
+		},
+		{
+			name: "no front matter",
+			text: "just a body\n",
+			want: FrontMatter{Tags: []string{}, Content: "just a body\n"},
+		},
+		{
+			name:    "unclosed front matter",
+			text:    "---\ntitle: Broken\nbody",
+			wantErr: true,
+		},
+		{
+			name: "properties",
+			text: "---\ntitle: Notes\nstatus: open\nowner: \n---\nbody",
+			want: FrontMatter{Title: "Notes", Tags: []string{}, Properties: map[string]string{"status": "open"}, Content: "body"},
+		},
+		{
+			name: "due date",
+			text: "---\ntitle: Notes\ndue: 2025-07-01\n---\nbody",
+			want: FrontMatter{Title: "Notes", Tags: []string{}, Due: "2025-07-01", Content: "body"},
+		},
+		{
+			name:    "invalid due date",
+			text:    "---\ndue: someday\n---\nbody",
+			wantErr: true,
+		},
+		{
+			name:    "invalid property name",
+			text:    "---\nmy status: open\n---\nbody",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFrontMatter(tt.text)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFrontMatter returned an error: %v", err)
+			}
+			if got.Title != tt.want.Title || got.Due != tt.want.Due || got.Content != tt.want.Content || !slices.Equal(got.Tags, tt.want.Tags) ||
+				!maps.Equal(got.Properties, tt.want.Properties) {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -15,6 +15,16 @@ type Note struct {
 	Content   string   `json:"content"`
 	CreatedAt string   `json:"created_at"`
 	UpdatedAt string   `json:"updated_at"`
+	// Properties hold custom metadata such as status or owner. They are shown as extra columns
+	// rather than by PrepRow, hence the table:"-" tag.
+	Properties map[string]string `json:"properties,omitempty" table:"-"`
+	// Due is an optional due date, stored as YYYY-MM-DD or YYYY-MM-DD HH:MM (see timeutils.Due)
+	Due string `json:"due,omitempty" table:"-"`
+	// Pinned notes are listed first, favorites are marked, and archived notes are hidden from
+	// list and search unless asked for (see States)
+	Pinned   bool `json:"pinned,omitempty" table:"-"`
+	Favorite bool `json:"favorite,omitempty" table:"-"`
+	Archived bool `json:"archived,omitempty" table:"-"`
 }
 
 func (n *Note) AddTag(tag string) {
@@ -57,9 +67,12 @@ func (n *Note) GetHeaders() []string {
 func (n *Note) PrepRow() []string {
 	v := reflect.ValueOf(n).Elem()
 	numFields := v.NumField()
-	row := make([]string, numFields)
+	row := make([]string, 0, numFields)
 	// iterate over fields and add type specific logic to format field as string in row
 	for i := 0; i < numFields; i++ {
+		if v.Type().Field(i).Tag.Get("table") == "-" {
+			continue
+		}
 		field := v.Field(i)
 
 		switch field.Kind() {
@@ -69,14 +82,14 @@ func (n *Note) PrepRow() []string {
 				for j := 0; j < field.Len(); j++ {
 					tags = append(tags, field.Index(j).String())
 				}
-				row[i] = strings.Join(tags, ", ")
+				row = append(row, strings.Join(tags, ", "))
 			} else {
-				row[i] = field.String()
+				row = append(row, field.String())
 			}
 		case reflect.Int:
-			row[i] = strconv.Itoa(int(field.Elem().Int()))
+			row = append(row, strconv.Itoa(int(field.Elem().Int())))
 		default:
-			row[i] = field.String()
+			row = append(row, field.String())
 		}
 	}
 	return row
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"time"
+
+	"github.com/landanqrew/simple-jot/internal/timeutils"
 )
 
 // FilterNotesByDate filters notes by date. date format for input is YYYY-MM-DD
@@ -12,7 +14,7 @@ func FilterNotesByDate(notes []Note, startDate string, endDate string) []Note {
 		if endDate == "" {
 			return notes
 		}
-		
+
 		ed, err := time.Parse(time.DateOnly, endDate)
 		if err != nil {
 			log.Fatal("failed to parse end date: " + err.Error() + " use format YYYY-MM-DD")
@@ -44,7 +46,7 @@ func FilterNotesByDate(notes []Note, startDate string, endDate string) []Note {
 					// include regardless
 					filteredNotes = append(filteredNotes, note)
 				}
-				if nd.After(sd) || nd.Equal(sd)  {
+				if nd.After(sd) || nd.Equal(sd) {
 					filteredNotes = append(filteredNotes, note)
 				}
 			}
@@ -78,3 +80,20 @@ func FilterNotesByDate(notes []Note, startDate string, endDate string) []Note {
 	}
 }
 
+// FilterNotesChangedSince returns the notes created or updated at or after since.
+// Notes with timestamps that cannot be parsed are included.
+func FilterNotesChangedSince(notes []Note, since time.Time) []Note {
+	filteredNotes := make([]Note, 0)
+	for _, note := range notes {
+		created, createdErr := timeutils.ParseNoteTime(note.CreatedAt)
+		updated, updatedErr := timeutils.ParseNoteTime(note.UpdatedAt)
+		if createdErr != nil && updatedErr != nil {
+			filteredNotes = append(filteredNotes, note)
+			continue
+		}
+		if (createdErr == nil && !created.Before(since)) || (updatedErr == nil && !updated.Before(since)) {
+			filteredNotes = append(filteredNotes, note)
+		}
+	}
+	return filteredNotes
+}
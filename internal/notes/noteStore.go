@@ -7,6 +7,9 @@ type NoteStore struct {
 }
 
 func (n *NoteStore) BuildNoteMap(notes []Note) {
+	if n.NoteMap == nil {
+		n.NoteMap = make(map[string]Note)
+	}
 	for _, note := range notes {
 		n.NoteMap[note.ID] = note
 	}
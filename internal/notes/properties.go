@@ -0,0 +1,106 @@
+package notes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// reservedPropertyKeys are front matter fields, so they cannot be used as property names.
+var reservedPropertyKeys = []string{"title", "tags", "due"}
+
+// ValidatePropertyKey reports whether key can name a property. Keys must be non-empty, without
+// whitespace, ':' or '=', and must not clash with the title, tags and due front matter fields.
+func ValidatePropertyKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("property name cannot be empty")
+	}
+	if strings.ContainsAny(key, ":= \t\n") {
+		return fmt.Errorf("property name '%s' cannot contain whitespace, ':' or '='", key)
+	}
+	if slices.Contains(reservedPropertyKeys, strings.ToLower(key)) {
+		return fmt.Errorf("'%s' is a note field and cannot be used as a property name", key)
+	}
+	return nil
+}
+
+// SetProperty sets the property key to value.
+func (n *Note) SetProperty(key, value string) {
+	if n.Properties == nil {
+		n.Properties = make(map[string]string)
+	}
+	n.Properties[key] = value
+	n.UpdatedAt = time.Now().Format(time.DateTime)
+}
+
+// GetProperty returns the value of the property key and whether it is set.
+func (n *Note) GetProperty(key string) (string, bool) {
+	value, ok := n.Properties[key]
+	return value, ok
+}
+
+// UnsetProperty removes the property key and reports whether it was set.
+func (n *Note) UnsetProperty(key string) bool {
+	if _, ok := n.Properties[key]; !ok {
+		return false
+	}
+	delete(n.Properties, key)
+	if len(n.Properties) == 0 {
+		n.Properties = nil
+	}
+	n.UpdatedAt = time.Now().Format(time.DateTime)
+	return true
+}
+
+// PropertyKeys returns the sorted names of the properties set on any of the notes.
+func PropertyKeys(noteList []Note) []string {
+	keys := make([]string, 0)
+	for _, n := range noteList {
+		for key := range n.Properties {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// GetHeadersWithProperties returns the table headers followed by one column per property key.
+func (n *Note) GetHeadersWithProperties(keys []string) []string {
+	return append(n.GetHeaders(), keys...)
+}
+
+// PrepRowWithProperties returns the table row followed by the note's value for each property key.
+func (n *Note) PrepRowWithProperties(keys []string) []string {
+	row := n.PrepRow()
+	for _, key := range keys {
+		row = append(row, n.Properties[key])
+	}
+	return row
+}
+
+// ParsePropertyFilter parses "key=value" into a filter matching that value, or "key" into a
+// filter matching any note with the property set.
+func ParsePropertyFilter(filter string) (key string, value string, hasValue bool, err error) {
+	key, value, hasValue = strings.Cut(filter, "=")
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return "", "", false, fmt.Errorf("invalid property filter '%s'. Use key=value or key", filter)
+	}
+	return key, strings.TrimSpace(value), hasValue, nil
+}
+
+// FilterNotesByProperty returns the notes with the property key set, and equal to value
+// (case-insensitive) when hasValue is true.
+func FilterNotesByProperty(noteList []Note, key, value string, hasValue bool) []Note {
+	filtered := []Note{}
+	for _, n := range noteList {
+		v, ok := n.GetProperty(key)
+		if ok && (!hasValue || strings.EqualFold(v, value)) {
+			filtered = append(filtered, n)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,127 @@
+package notes
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestPropertiesRoundTripThroughJSON(t *testing.T) {
+	note := Note{ID: "1", Title: "Ticket", Tags: []string{}, Properties: map[string]string{"status": "open", "owner": "sam"}}
+
+	data, err := json.Marshal([]Note{note, {ID: "2", Tags: []string{}}})
+	if err != nil {
+		t.Fatalf("Failed to marshal notes: %v", err)
+	}
+	var decoded []Note
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal notes: %v", err)
+	}
+	if !maps.Equal(decoded[0].Properties, note.Properties) {
+		t.Errorf("Expected properties %v, got %v", note.Properties, decoded[0].Properties)
+	}
+	if decoded[1].Properties != nil {
+		t.Errorf("Expected no properties on a note without them, got %v", decoded[1].Properties)
+	}
+}
+
+func TestPrepRowWithProperties(t *testing.T) {
+	noteList := []Note{
+		{ID: "1", Title: "A", Tags: []string{"x"}, Properties: map[string]string{"status": "open"}},
+		{ID: "2", Title: "B", Tags: []string{}, Properties: map[string]string{"owner": "sam", "status": "done"}},
+		{ID: "3", Title: "C", Tags: []string{}},
+	}
+	keys := PropertyKeys(noteList)
+	if !slices.Equal(keys, []string{"owner", "status"}) {
+		t.Fatalf("Expected property keys [owner status], got %v", keys)
+	}
+
+	if got := noteList[0].PrepRow(); len(got) != len(noteList[0].GetHeaders()) {
+		t.Errorf("Expected PrepRow to skip properties, got %v", got)
+	}
+	headers := noteList[0].GetHeadersWithProperties(keys)
+	if !slices.Equal(headers[len(headers)-2:], []string{"owner", "status"}) {
+		t.Errorf("Expected property headers at the end, got %v", headers)
+	}
+	wantTails := [][]string{{"", "open"}, {"sam", "done"}, {"", ""}}
+	for i, n := range noteList {
+		row := n.PrepRowWithProperties(keys)
+		if len(row) != len(headers) || !slices.Equal(row[len(row)-2:], wantTails[i]) {
+			t.Errorf("Note %s: expected row ending in %v, got %v", n.ID, wantTails[i], row)
+		}
+	}
+}
+
+func TestSetAndUnsetProperty(t *testing.T) {
+	note := Note{ID: "1"}
+	note.SetProperty("status", "open")
+	if value, ok := note.GetProperty("status"); !ok || value != "open" {
+		t.Errorf("Expected status=open, got %q (set: %t)", value, ok)
+	}
+	if note.UpdatedAt == "" {
+		t.Error("Expected SetProperty to update UpdatedAt")
+	}
+	if !note.UnsetProperty("status") {
+		t.Error("Expected UnsetProperty to report the property was set")
+	}
+	if note.UnsetProperty("status") {
+		t.Error("Expected UnsetProperty to report a missing property")
+	}
+	if note.Properties != nil {
+		t.Errorf("Expected no properties left, got %v", note.Properties)
+	}
+}
+
+func TestFilterNotesByProperty(t *testing.T) {
+	noteList := []Note{
+		{ID: "1", Properties: map[string]string{"status": "Open"}},
+		{ID: "2", Properties: map[string]string{"status": "done"}},
+		{ID: "3"},
+	}
+	tests := []struct {
+		filter   string
+		expected []string
+		wantErr  bool
+	}{
+		{filter: "status=open", expected: []string{"1"}},
+		{filter: "status", expected: []string{"1", "2"}},
+		{filter: "status=", expected: []string{}},
+		{filter: "owner=sam", expected: []string{}},
+		{filter: "=open", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			key, value, hasValue, err := ParsePropertyFilter(tt.filter)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePropertyFilter returned an error: %v", err)
+			}
+			ids := []string{}
+			for _, n := range FilterNotesByProperty(noteList, key, value, hasValue) {
+				ids = append(ids, n.ID)
+			}
+			if !slices.Equal(ids, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, ids)
+			}
+		})
+	}
+}
+
+func TestValidatePropertyKey(t *testing.T) {
+	for _, key := range []string{"status", "due-date", "ticket_id"} {
+		if err := ValidatePropertyKey(key); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", key, err)
+		}
+	}
+	for _, key := range []string{"", "my status", "a=b", "a:b", "Title", "tags"} {
+		if err := ValidatePropertyKey(key); err == nil {
+			t.Errorf("Expected %q to be rejected", key)
+		}
+	}
+}
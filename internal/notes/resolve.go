@@ -0,0 +1,85 @@
+package notes
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ActiveNoteRef refers to the active note wherever a note argument is accepted.
+const ActiveNoteRef = "@"
+
+// MinPrefixLength is the shortest ID prefix accepted by Resolve, to keep typos from matching.
+const MinPrefixLength = 4
+
+// AmbiguousRefError is returned by Resolve when a reference matches more than one note.
+type AmbiguousRefError struct {
+	Ref        string
+	Candidates []Note
+}
+
+func (e *AmbiguousRefError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "note reference '%s' is ambiguous. Candidates:", e.Ref)
+	for _, n := range e.Candidates {
+		fmt.Fprintf(&b, "\n  %s  %s", n.ID, n.Title)
+	}
+	return b.String()
+}
+
+// Slugify lower-cases title and joins its words with hyphens, e.g. "Q3 Planning: API" becomes
+// "q3-planning-api".
+func Slugify(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+// Resolve returns the index in noteList of the note that ref refers to. ref may be a full ID, an
+// exact title (case-insensitive), a slugified title, a unique ID prefix of at least
+// MinPrefixLength characters, or ActiveNoteRef for the note with activeID. Matches are tried in
+// that order and the first kind that matches wins; several matches of the same kind return an
+// *AmbiguousRefError listing them.
+func Resolve(noteList []Note, ref string, activeID string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	active := ref == ActiveNoteRef
+	if active {
+		if activeID == "" {
+			return -1, fmt.Errorf("no active note is set. Set one using 'simple-jot config set note <note>'")
+		}
+		ref = activeID
+	}
+	if ref == "" {
+		return -1, fmt.Errorf("note reference cannot be empty")
+	}
+
+	matchers := []func(n Note) bool{
+		func(n Note) bool { return n.ID == ref },
+		func(n Note) bool { return strings.EqualFold(n.Title, ref) },
+		func(n Note) bool { slug := Slugify(ref); return slug != "" && Slugify(n.Title) == slug },
+		func(n Note) bool {
+			return len(ref) >= MinPrefixLength && strings.HasPrefix(strings.ToLower(n.ID), strings.ToLower(ref))
+		},
+	}
+	for _, matches := range matchers {
+		found := -1
+		var candidates []Note
+		for i, n := range noteList {
+			if matches(n) {
+				found = i
+				candidates = append(candidates, n)
+			}
+		}
+		if len(candidates) == 1 {
+			return found, nil
+		}
+		if len(candidates) > 1 {
+			return -1, &AmbiguousRefError{Ref: ref, Candidates: candidates}
+		}
+	}
+	if active {
+		return -1, fmt.Errorf("the active note '%s' no longer exists. Set another using 'simple-jot config set note <note>'", ref)
+	}
+	return -1, fmt.Errorf("no note matches '%s'", ref)
+}
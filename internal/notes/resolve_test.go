@@ -0,0 +1,69 @@
+package notes
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	noteList := []Note{
+		{ID: "3f2a9c1e-0000-4000-8000-000000000001", Title: "Q3 Planning: API"},
+		{ID: "3f2a7d4a-0000-4000-8000-000000000002", Title: "Standup"},
+		{ID: "9e1c0b22-0000-4000-8000-000000000003", Title: "standup"},
+		{ID: "abcd1234-0000-4000-8000-000000000004", Title: "9e1c"},
+	}
+
+	tests := []struct {
+		name      string
+		ref       string
+		activeID  string
+		wantIdx   int
+		ambiguous int
+		wantErr   string
+	}{
+		{name: "full ID", ref: noteList[1].ID, wantIdx: 1},
+		{name: "unique prefix", ref: "3F2A7", wantIdx: 1},
+		{name: "exact title", ref: "q3 planning: api", wantIdx: 0},
+		{name: "slug", ref: "q3-planning-api", wantIdx: 0},
+		{name: "title wins over prefix", ref: "9e1c", wantIdx: 3},
+		{name: "active note", ref: "@", activeID: noteList[2].ID, wantIdx: 2},
+		{name: "ambiguous title", ref: "Standup", ambiguous: 2},
+		{name: "ambiguous prefix", ref: "3f2a", ambiguous: 2},
+		{name: "short prefix", ref: "9e1", wantErr: "no note matches '9e1'"},
+		{name: "no active note", ref: "@", wantErr: "no active note"},
+		{name: "deleted active note", ref: "@", activeID: "gone", wantErr: "the active note 'gone' no longer exists"},
+		{name: "unknown", ref: "missing", wantErr: "no note matches 'missing'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx, err := Resolve(noteList, tt.ref, tt.activeID)
+			if tt.ambiguous > 0 {
+				var ambiguous *AmbiguousRefError
+				if !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != tt.ambiguous {
+					t.Fatalf("Expected %d ambiguous candidates, got %v", tt.ambiguous, err)
+				}
+				if !strings.Contains(err.Error(), ambiguous.Candidates[1].ID) {
+					t.Errorf("Expected the candidates to be listed, got %q", err.Error())
+				}
+				return
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || idx != tt.wantIdx {
+				t.Errorf("Expected index %d, got %d (%v)", tt.wantIdx, idx, err)
+			}
+		})
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	if got := Slugify("  Q3 Planning: API / Auth  "); got != "q3-planning-api-auth" {
+		t.Errorf("Expected q3-planning-api-auth, got %s", got)
+	}
+}
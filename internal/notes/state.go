@@ -0,0 +1,58 @@
+package notes
+
+import "slices"
+
+// ArchiveFilter selects notes by whether they are archived.
+type ArchiveFilter int
+
+const (
+	// HideArchived keeps only notes that are not archived. It is the default for listings.
+	HideArchived ArchiveFilter = iota
+	// OnlyArchived keeps only archived notes.
+	OnlyArchived
+	// AllNotes keeps every note.
+	AllNotes
+)
+
+// States returns labels for the pinned, favorite and archived states set on the note.
+func (n *Note) States() []string {
+	states := make([]string, 0, 3)
+	if n.Pinned {
+		states = append(states, "pinned")
+	}
+	if n.Favorite {
+		states = append(states, "fav")
+	}
+	if n.Archived {
+		states = append(states, "archived")
+	}
+	return states
+}
+
+// SortPinnedFirst moves pinned notes before the others, keeping the order within each group.
+func SortPinnedFirst(noteList []Note) {
+	slices.SortStableFunc(noteList, func(a, b Note) int {
+		switch {
+		case a.Pinned == b.Pinned:
+			return 0
+		case a.Pinned:
+			return -1
+		default:
+			return 1
+		}
+	})
+}
+
+// FilterNotesByArchive returns the notes selected by filter.
+func FilterNotesByArchive(noteList []Note, filter ArchiveFilter) []Note {
+	if filter == AllNotes {
+		return noteList
+	}
+	filtered := []Note{}
+	for _, n := range noteList {
+		if n.Archived == (filter == OnlyArchived) {
+			filtered = append(filtered, n)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,54 @@
+package notes
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortPinnedFirst(t *testing.T) {
+	noteList := []Note{{ID: "a"}, {ID: "b", Pinned: true}, {ID: "c"}, {ID: "d", Pinned: true}}
+	SortPinnedFirst(noteList)
+
+	ids := make([]string, 0, len(noteList))
+	for _, n := range noteList {
+		ids = append(ids, n.ID)
+	}
+	if expected := []string{"b", "d", "a", "c"}; !slices.Equal(ids, expected) {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+}
+
+func TestFilterNotesByArchive(t *testing.T) {
+	noteList := []Note{{ID: "a"}, {ID: "b", Archived: true}, {ID: "c", Pinned: true}}
+
+	tests := []struct {
+		name     string
+		filter   ArchiveFilter
+		expected []string
+	}{
+		{name: "Hide archived", filter: HideArchived, expected: []string{"a", "c"}},
+		{name: "Only archived", filter: OnlyArchived, expected: []string{"b"}},
+		{name: "All notes", filter: AllNotes, expected: []string{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := make([]string, 0)
+			for _, n := range FilterNotesByArchive(noteList, tt.filter) {
+				ids = append(ids, n.ID)
+			}
+			if !slices.Equal(ids, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, ids)
+			}
+		})
+	}
+}
+
+func TestStates(t *testing.T) {
+	n := Note{Pinned: true, Archived: true}
+	if states := n.States(); !slices.Equal(states, []string{"pinned", "archived"}) {
+		t.Errorf("Expected [pinned archived], got %v", states)
+	}
+	if states := (&Note{}).States(); len(states) != 0 {
+		t.Errorf("Expected no states, got %v", states)
+	}
+}
@@ -0,0 +1,42 @@
+package osutils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Confirm writes question to out and reads a yes/no answer from in.
+// Anything other than "y" or "yes", including end of input, counts as no.
+func Confirm(in io.Reader, out io.Writer, question string) (bool, error) {
+	fmt.Fprintf(out, "%s [y/N]: ", question)
+	answer, err := ReadLine(in)
+	if err != nil {
+		return false, err
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// ReadLine reads a single line from in without the trailing newline. It reads one byte at a time
+// so that nothing past the newline is consumed, which lets callers ask several questions in a row.
+func ReadLine(in io.Reader) (string, error) {
+	var line []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := in.Read(buf)
+		if n == 1 {
+			if buf[0] == '\n' {
+				break
+			}
+			line = append(line, buf[0])
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
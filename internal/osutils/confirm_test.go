@@ -0,0 +1,47 @@
+package osutils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{name: "yes", input: "y\n", expected: true},
+		{name: "full yes", input: " YES \n", expected: true},
+		{name: "no", input: "n\n", expected: false},
+		{name: "empty line", input: "\n", expected: false},
+		{name: "end of input", input: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			got, err := Confirm(strings.NewReader(tt.input), out, "Apply?")
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %t, got %t", tt.expected, got)
+			}
+			if out.String() != "Apply? [y/N]: " {
+				t.Errorf("Unexpected prompt %q", out.String())
+			}
+		})
+	}
+}
+
+func TestConfirmReadsOneLineAtATime(t *testing.T) {
+	in := strings.NewReader("y\nn\n")
+	out := new(bytes.Buffer)
+	first, _ := Confirm(in, out, "first?")
+	second, _ := Confirm(in, out, "second?")
+	if !first || second {
+		t.Errorf("Expected answers yes then no, got %t then %t", first, second)
+	}
+}
@@ -0,0 +1,56 @@
+package osutils
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// EditText opens initial in editor and returns the saved text. The text is written to a
+// temporary file named after pattern (see os.CreateTemp), so the extension can pick the
+// editor's syntax highlighting. editor may include arguments, e.g. "code --wait".
+func EditText(editor string, initial string, pattern string) (string, error) {
+	if strings.TrimSpace(editor) == "" {
+		return "", fmt.Errorf("no editor configured")
+	}
+
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+	if _, err := file.WriteString(initial); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	cmd := editorCommand(editor, path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read temporary file: %w", err)
+	}
+	return string(edited), nil
+}
+
+// editorCommand builds the command that opens path in editor. On Unix the editor is run through
+// the shell, like git does, so quoted paths and arguments in $EDITOR keep working.
+func editorCommand(editor string, path string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		args := strings.Fields(editor)
+		return exec.Command(args[0], append(args[1:], path)...)
+	}
+	return exec.Command("sh", "-c", editor+` "$@"`, editor, path)
+}
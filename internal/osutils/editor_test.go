@@ -0,0 +1,29 @@
+package osutils
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestEditText(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test editor is a shell command")
+	}
+
+	// a shell function stands in for an interactive editor
+	editor := `edit() { sed "s/draft/final/" "$1" > "$1.tmp" && mv "$1.tmp" "$1"; }; edit`
+	edited, err := EditText(editor, "title: draft\n", "note-*.md")
+	if err != nil {
+		t.Fatalf("EditText returned an error: %v", err)
+	}
+	if edited != "title: final\n" {
+		t.Errorf("Expected the edited text, got %q", edited)
+	}
+
+	if _, err := EditText("false", "text", "note-*.md"); err == nil {
+		t.Error("Expected an error when the editor fails")
+	}
+	if _, err := EditText("", "text", "note-*.md"); err == nil {
+		t.Error("Expected an error when no editor is configured")
+	}
+}
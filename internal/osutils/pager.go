@@ -0,0 +1,62 @@
+package osutils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// defaultPager is used when $PAGER is not set. -R keeps the colours of rendered Markdown.
+const defaultPager = "less -R"
+
+// Page writes text to out, through $PAGER when out is a terminal and text is taller than it.
+// It falls back to writing directly when no pager can be started.
+func Page(out io.Writer, text string) error {
+	file, ok := out.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		_, err := io.WriteString(out, text)
+		return err
+	}
+	_, height, err := term.GetSize(int(file.Fd()))
+	if err != nil || strings.Count(text, "\n") < height {
+		_, err := io.WriteString(out, text)
+		return err
+	}
+
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		pager = defaultPager
+	}
+	args := strings.Fields(pager)
+	if len(args) == 0 {
+		_, err := io.WriteString(out, text)
+		return err
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = file
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return fmt.Errorf("pager %q failed: %w", pager, err)
+		}
+		// the pager is not installed
+		_, err := io.WriteString(out, text)
+		return err
+	}
+	return nil
+}
+
+// TerminalWidth returns the width of out when it is a terminal, or fallback otherwise.
+func TerminalWidth(out io.Writer, fallback int) int {
+	if file, ok := out.(*os.File); ok {
+		if width, _, err := term.GetSize(int(file.Fd())); err == nil && width > 0 {
+			return width
+		}
+	}
+	return fallback
+}
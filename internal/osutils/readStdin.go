@@ -22,4 +22,11 @@ func ReadStdin() (string, error) {
 	}
 
 	return "", nil
-}
\ No newline at end of file
+}
+
+// StdinIsTerminal reports whether stdin is a terminal rather than a pipe or redirect, i.e. whether
+// the user can be asked questions on it.
+func StdinIsTerminal() bool {
+	stat, err := os.Stdin.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
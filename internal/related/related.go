@@ -0,0 +1,105 @@
+package related
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/landanqrew/simple-jot/internal/ai"
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/tags"
+)
+
+// Weights of each signal in the combined score. Signals that are unavailable, such as semantic
+// similarity without an LLM provider, are left out and the remaining weights are rescaled.
+const (
+	TagWeight      = 0.4
+	TextWeight     = 0.3
+	SemanticWeight = 0.3
+)
+
+// minTextScore is the text similarity below which shared wording is not mentioned as a reason.
+const minTextScore = 0.1
+
+// Recommendation is a note related to the target note, with the reasons it was suggested.
+type Recommendation struct {
+	Note          notes.Note
+	Score         float64
+	TagScore      float64
+	TextScore     float64
+	SemanticScore float64
+	Reasons       []string
+}
+
+func (r Recommendation) PrepRow() []string {
+	return []string{r.Note.ID, r.Note.Title, fmt.Sprintf("%.2f %%", r.Score*100), strings.Join(r.Reasons, "; ")}
+}
+
+// Rank scores every other note in noteList against target by shared tags and text similarity,
+// plus semantic similarity when semantic scores (keyed by note ID) are given, and returns up to
+// limit notes with a positive score, best first.
+func Rank(target notes.Note, noteList []notes.Note, semantic map[string]float64, limit int) ([]Recommendation, error) {
+	tagMap := tags.TagMap{}
+	tagMap.BuildTagMap(noteList)
+	sharedTags := make(map[string][]string)
+	for _, tag := range target.Tags {
+		for _, noteID := range tagMap.GetNotesForTag(tag) {
+			sharedTags[noteID] = append(sharedTags[noteID], tag)
+		}
+	}
+
+	embedder := ai.NewHashEmbedder(ai.DefaultHashDimensions)
+	texts := make([]string, len(noteList)+1)
+	texts[0] = target.Title + "\n" + target.Content
+	for i, n := range noteList {
+		texts[i+1] = n.Title + "\n" + n.Content
+	}
+	vectors, err := embedder.Embed(texts)
+	if err != nil {
+		return nil, err
+	}
+
+	weightSum := TagWeight + TextWeight
+	if semantic != nil {
+		weightSum += SemanticWeight
+	}
+
+	recommendations := make([]Recommendation, 0)
+	for i, n := range noteList {
+		if n.ID == target.ID {
+			continue
+		}
+		rec := Recommendation{Note: n}
+
+		if shared := sharedTags[n.ID]; len(shared) > 0 {
+			slices.Sort(shared)
+			rec.TagScore = float64(len(shared)) / float64(len(target.Tags)+len(n.Tags)-len(shared))
+			rec.Reasons = append(rec.Reasons, "shared tags: "+strings.Join(shared, ", "))
+		}
+
+		rec.TextScore = max(ai.CosineSimilarity(vectors[0], vectors[i+1]), 0)
+		if rec.TextScore >= minTextScore {
+			rec.Reasons = append(rec.Reasons, fmt.Sprintf("similar wording (%.0f%%)", rec.TextScore*100))
+		}
+
+		if score, ok := semantic[n.ID]; ok && score > 0 {
+			rec.SemanticScore = score
+			rec.Reasons = append(rec.Reasons, fmt.Sprintf("semantically similar (%.0f%%)", score*100))
+		}
+
+		rec.Score = (TagWeight*rec.TagScore + TextWeight*rec.TextScore + SemanticWeight*rec.SemanticScore) / weightSum
+		if len(rec.Reasons) == 0 {
+			continue
+		}
+		recommendations = append(recommendations, rec)
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+	if limit > 0 && len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+	return recommendations, nil
+}
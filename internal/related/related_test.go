@@ -0,0 +1,55 @@
+package related
+
+import (
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestRank(t *testing.T) {
+	target := notes.Note{ID: "t", Title: "Cache TTL", Tags: []string{"cache", "api"}, Content: "Decided the cache TTL is five minutes for the API gateway."}
+	noteList := []notes.Note{
+		target,
+		{ID: "tags", Title: "API rate limits", Tags: []string{"api", "cache"}, Content: "Rate limiting per tenant."},
+		{ID: "text", Title: "Gateway", Tags: []string{}, Content: "The API gateway cache TTL should be revisited."},
+		{ID: "none", Title: "Lunch", Tags: []string{"food"}, Content: "Pizza on Friday."},
+		{ID: "semantic", Title: "Invalidation", Tags: []string{}, Content: "Purging stale entries."},
+	}
+
+	recommendations, err := Rank(target, noteList, nil, 10)
+	if err != nil {
+		t.Fatalf("Rank returned an error: %v", err)
+	}
+	ids := make([]string, len(recommendations))
+	for i, rec := range recommendations {
+		ids[i] = rec.Note.ID
+		if rec.Note.ID == "t" {
+			t.Error("Expected the target note to be excluded")
+		}
+		if len(rec.Reasons) == 0 {
+			t.Errorf("Expected reasons for %s", rec.Note.ID)
+		}
+	}
+	if len(ids) != 2 || ids[0] != "tags" || ids[1] != "text" {
+		t.Errorf("Expected [tags text], got %v", ids)
+	}
+
+	recommendations, err = Rank(target, noteList, map[string]float64{"semantic": 0.9}, 10)
+	if err != nil {
+		t.Fatalf("Rank returned an error: %v", err)
+	}
+	found := false
+	for _, rec := range recommendations {
+		if rec.Note.ID == "semantic" {
+			found = rec.SemanticScore == 0.9
+		}
+	}
+	if !found {
+		t.Error("Expected the semantic match to be recommended")
+	}
+
+	recommendations, _ = Rank(target, noteList, nil, 1)
+	if len(recommendations) != 1 {
+		t.Errorf("Expected the limit to be applied, got %d", len(recommendations))
+	}
+}
@@ -2,6 +2,8 @@ package requests
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 )
@@ -17,4 +19,40 @@ func MakeRequest(url string, payload []byte) ([]byte, error) {
 		return nil, err
 	}
 	return body, nil
-}
\ No newline at end of file
+}
+
+// StatusError is returned by PostJSON when the server replies with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// PostJSON posts payload as JSON with the given extra headers and returns the response body.
+func PostJSON(ctx context.Context, url string, headers map[string]string, payload []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, &StatusError{StatusCode: res.StatusCode, Body: string(body)}
+	}
+	return body, nil
+}
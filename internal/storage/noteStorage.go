@@ -56,7 +56,13 @@ func (s *FileNoteStorage) SaveNotes(notes []notes.Note) error {
 		return fmt.Errorf("failed to create directory: %v", err)
 	}
 
-	if err := os.WriteFile(s.filePath, data, 0644); err != nil {
+	// write to a temporary file and rename it so a failed save never leaves a partial store
+	tmpPath := s.filePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write notes to file: %v", err)
+	}
+	if err := os.Rename(tmpPath, s.filePath); err != nil {
+		os.Remove(tmpPath)
 		return fmt.Errorf("failed to write notes to file: %v", err)
 	}
 
@@ -0,0 +1,90 @@
+package tags
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	notes "github.com/landanqrew/simple-jot/internal/notes"
+)
+
+// Normalizer maps tags onto their canonical spelling so that e.g. "k8s" and "kubernetes" are
+// treated as the same tag.
+type Normalizer struct {
+	// Aliases maps an alias to its canonical tag. Aliases are matched case-insensitively.
+	Aliases map[string]string
+	// FoldCase lower-cases every tag, so "TODO" and "todo" are the same tag.
+	FoldCase bool
+}
+
+// Normalize trims tag and each of its levels, folds its case if enabled and replaces an alias with
+// its canonical tag. "project / api/" becomes "project/api".
+func (n Normalizer) Normalize(tag string) string {
+	segments := make([]string, 0, 1)
+	for _, segment := range strings.Split(tag, Separator) {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	tag = strings.Join(segments, Separator)
+	if n.FoldCase {
+		tag = strings.ToLower(tag)
+	}
+	for alias, canonical := range n.Aliases {
+		if strings.EqualFold(alias, tag) {
+			tag = canonical
+			if n.FoldCase {
+				tag = strings.ToLower(tag)
+			}
+			break
+		}
+	}
+	return tag
+}
+
+// BuildNormalizedTagMap indexes notes like BuildTagMap, but under the normalized form of each tag.
+// Tags that normalize to nothing are skipped.
+func (t *TagMap) BuildNormalizedTagMap(noteList []notes.Note, n Normalizer) {
+	if t.TagMap == nil {
+		t.TagMap = make(map[string]map[string]struct{})
+	}
+	for _, note := range noteList {
+		for _, tag := range note.Tags {
+			if tag = n.Normalize(tag); tag != "" {
+				t.AddTag(note.ID, tag)
+			}
+		}
+	}
+}
+
+// Rewrite replaces every tag that normalizes to one of the from tags with the normalized form of to
+// on every note in noteList, keeping the position of the first replaced tag and dropping
+// duplicates. It returns the number of notes that changed.
+func (n Normalizer) Rewrite(noteList []notes.Note, from []string, to string) int {
+	to = n.Normalize(to)
+	normalizedFrom := make([]string, 0, len(from))
+	for _, tag := range from {
+		normalizedFrom = append(normalizedFrom, n.Normalize(tag))
+	}
+
+	changed := 0
+	for i := range noteList {
+		note := &noteList[i]
+		newTags := make([]string, 0, len(note.Tags))
+		for _, tag := range note.Tags {
+			if slices.Contains(normalizedFrom, n.Normalize(tag)) {
+				tag = to
+			}
+			if !slices.Contains(newTags, tag) {
+				newTags = append(newTags, tag)
+			}
+		}
+		if slices.Equal(newTags, note.Tags) {
+			continue
+		}
+		note.Tags = newTags
+		note.UpdatedAt = time.Now().Format(time.DateTime)
+		changed++
+	}
+	return changed
+}
@@ -0,0 +1,95 @@
+package tags
+
+import (
+	"slices"
+	"testing"
+
+	notes "github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestNormalize(t *testing.T) {
+	aliases := map[string]string{"k8s": "kubernetes", "todos": "TODO"}
+	tests := []struct {
+		name     string
+		tag      string
+		foldCase bool
+		want     string
+	}{
+		{name: "Plain tag", tag: " work ", want: "work"},
+		{name: "Alias", tag: "k8s", want: "kubernetes"},
+		{name: "Alias matched case-insensitively", tag: "K8S", want: "kubernetes"},
+		{name: "Case kept without folding", tag: "TODO", want: "TODO"},
+		{name: "Case folded", tag: "TODO", foldCase: true, want: "todo"},
+		{name: "Canonical tag folded", tag: "todos", foldCase: true, want: "todo"},
+		{name: "Hierarchy cleaned", tag: " project / api//auth/ ", want: "project/api/auth"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Normalizer{Aliases: aliases, FoldCase: tt.foldCase}
+			if got := n.Normalize(tt.tag); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildNormalizedTagMap(t *testing.T) {
+	noteList := []notes.Note{
+		{ID: "1", Tags: []string{"k8s"}},
+		{ID: "2", Tags: []string{"Kubernetes"}},
+	}
+	tagMap := TagMap{}
+	tagMap.BuildNormalizedTagMap(noteList, Normalizer{Aliases: map[string]string{"k8s": "kubernetes"}, FoldCase: true})
+
+	got := tagMap.GetNotesForTag("kubernetes")
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"1", "2"}) {
+		t.Errorf("Expected both notes under kubernetes, got %v", got)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	noteList := []notes.Note{
+		{ID: "1", Tags: []string{"k8s", "ops", "kubernetes"}},
+		{ID: "2", Tags: []string{"kube"}},
+		{ID: "3", Tags: []string{"ops"}, UpdatedAt: "2025-01-01 00:00:00"},
+	}
+
+	changed := Normalizer{}.Rewrite(noteList, []string{"k8s", "kube"}, "kubernetes")
+	if changed != 2 {
+		t.Errorf("Expected 2 notes to change, got %d", changed)
+	}
+	want := [][]string{{"kubernetes", "ops"}, {"kubernetes"}, {"ops"}}
+	for i, n := range noteList {
+		if !slices.Equal(n.Tags, want[i]) {
+			t.Errorf("Note %s: expected tags %v, got %v", n.ID, want[i], n.Tags)
+		}
+	}
+	if noteList[2].UpdatedAt != "2025-01-01 00:00:00" {
+		t.Error("Expected untouched notes to keep their UpdatedAt")
+	}
+}
+
+func TestRewriteNormalizes(t *testing.T) {
+	noteList := []notes.Note{
+		{ID: "1", Tags: []string{"k8s", "ops"}},
+		{ID: "2", Tags: []string{"K8S"}},
+		{ID: "3", Tags: []string{"kubernetes"}, UpdatedAt: "2025-01-01 00:00:00"},
+	}
+	normalizer := Normalizer{Aliases: map[string]string{"kube": "kubernetes"}, FoldCase: true}
+
+	// "kube" is an alias, so the notes get the canonical tag
+	changed := normalizer.Rewrite(noteList, []string{"K8S"}, "kube")
+	if changed != 2 {
+		t.Errorf("Expected 2 notes to change, got %d", changed)
+	}
+	want := [][]string{{"kubernetes", "ops"}, {"kubernetes"}, {"kubernetes"}}
+	for i, n := range noteList {
+		if !slices.Equal(n.Tags, want[i]) {
+			t.Errorf("Note %s: expected tags %v, got %v", n.ID, want[i], n.Tags)
+		}
+	}
+	if noteList[2].UpdatedAt != "2025-01-01 00:00:00" {
+		t.Error("Expected a note already using the new tag to keep its UpdatedAt")
+	}
+}
@@ -11,6 +11,9 @@ type TagMap struct {
 }
 
 func (t *TagMap) BuildTagMap(notes []notes.Note) {
+	if t.TagMap == nil {
+		t.TagMap = make(map[string]map[string]struct{})
+	}
 	for _, note := range notes {
 		for _, tag := range note.Tags {
 			if _, ok := t.TagMap[tag]; !ok {
@@ -56,14 +59,14 @@ func (t *TagMap) GetNotesForTag(tag string) []string {
 	return notes
 }
 
+// GetAllTags returns the tags starting with prefix, in no particular order. The map is rebuilt
+// for every command, so a trie such as Tree would cost a full scan to build before any lookup.
 func (t *TagMap) GetAllTags(prefix string) []string {
 	tags := make([]string, 0, len(t.TagMap))
 	for tag := range t.TagMap {
-		if prefix != "" && strings.HasPrefix(tag, prefix) { // TODO: maybe base this on a trie for faster lookups?
-			tags = append(tags, tag)
-		} else if prefix == "" {
+		if strings.HasPrefix(tag, prefix) {
 			tags = append(tags, tag)
 		}
 	}
 	return tags
-}
\ No newline at end of file
+}
@@ -0,0 +1,115 @@
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Separator splits hierarchical tags such as "project/api/auth" into levels.
+const Separator = "/"
+
+// TagNode is one level of the tag hierarchy, e.g. "api" in "project/api/auth".
+type TagNode struct {
+	Name     string              // last segment, e.g. "api"
+	Path     string              // full tag, e.g. "project/api"
+	NoteIDs  map[string]struct{} // notes tagged with exactly Path
+	Children map[string]*TagNode
+}
+
+func newTagNode(name, path string) *TagNode {
+	return &TagNode{Name: name, Path: path, NoteIDs: make(map[string]struct{}), Children: make(map[string]*TagNode)}
+}
+
+// Tree builds a trie of the tags in the map, one node per "/"-separated segment. The returned
+// root has an empty path and holds the top-level tags as children.
+func (t *TagMap) Tree() *TagNode {
+	root := newTagNode("", "")
+	for tag, noteIDs := range t.TagMap {
+		node := root
+		for _, segment := range strings.Split(tag, Separator) {
+			child, ok := node.Children[segment]
+			if !ok {
+				child = newTagNode(segment, strings.TrimPrefix(node.Path+Separator+segment, Separator))
+				node.Children[segment] = child
+			}
+			node = child
+		}
+		for noteID := range noteIDs {
+			node.NoteIDs[noteID] = struct{}{}
+		}
+	}
+	return root
+}
+
+// Find returns the node for path, or nil if no tag starts with it.
+func (n *TagNode) Find(path string) *TagNode {
+	node := n
+	for _, segment := range strings.Split(path, Separator) {
+		if node = node.Children[segment]; node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// SubtreeNoteIDs returns the notes tagged with the node's tag or any tag below it.
+func (n *TagNode) SubtreeNoteIDs() []string {
+	seen := make(map[string]struct{})
+	n.walk(func(node *TagNode) {
+		for noteID := range node.NoteIDs {
+			seen[noteID] = struct{}{}
+		}
+	})
+	noteIDs := make([]string, 0, len(seen))
+	for noteID := range seen {
+		noteIDs = append(noteIDs, noteID)
+	}
+	slices.Sort(noteIDs)
+	return noteIDs
+}
+
+// Format renders the hierarchy below n with the number of notes in each subtree, e.g.
+//
+//	project (3)
+//	├── api (2)
+//	│   └── auth (1)
+//	└── web (1)
+func (n *TagNode) Format() string {
+	var b strings.Builder
+	var render func(node *TagNode, indent string)
+	render = func(node *TagNode, indent string) {
+		children := node.sortedChildren()
+		for i, child := range children {
+			branch, nextIndent := "├── ", "│   "
+			if i == len(children)-1 {
+				branch, nextIndent = "└── ", "    "
+			}
+			if node.Path == "" {
+				// top-level tags start at the margin
+				branch, nextIndent = "", ""
+			}
+			fmt.Fprintf(&b, "%s%s%s (%d)\n", indent, branch, child.Name, len(child.SubtreeNoteIDs()))
+			render(child, indent+nextIndent)
+		}
+	}
+	render(n, "")
+	return b.String()
+}
+
+// walk calls fn for n and every node below it.
+func (n *TagNode) walk(fn func(node *TagNode)) {
+	fn(n)
+	for _, child := range n.Children {
+		child.walk(fn)
+	}
+}
+
+func (n *TagNode) sortedChildren() []*TagNode {
+	children := make([]*TagNode, 0, len(n.Children))
+	for _, child := range n.Children {
+		children = append(children, child)
+	}
+	slices.SortFunc(children, func(a, b *TagNode) int { return strings.Compare(a.Name, b.Name) })
+	return children
+}
@@ -0,0 +1,64 @@
+package tags
+
+import (
+	"slices"
+	"testing"
+
+	notes "github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func testTagTree() *TagNode {
+	tagMap := TagMap{}
+	tagMap.BuildTagMap([]notes.Note{
+		{ID: "1", Tags: []string{"project/api/auth"}},
+		{ID: "2", Tags: []string{"project/api", "project/web"}},
+		{ID: "3", Tags: []string{"project/web"}},
+		{ID: "4", Tags: []string{"personal", "project-x"}},
+	})
+	return tagMap.Tree()
+}
+
+func TestTagTreeFind(t *testing.T) {
+	tree := testTagTree()
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "project", want: []string{"1", "2", "3"}},
+		{path: "project/api", want: []string{"1", "2"}},
+		{path: "project/api/auth", want: []string{"1"}},
+		{path: "project-x", want: []string{"4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			node := tree.Find(tt.path)
+			if node == nil {
+				t.Fatalf("Expected a node for %q", tt.path)
+			}
+			if node.Path != tt.path {
+				t.Errorf("Expected path %q, got %q", tt.path, node.Path)
+			}
+			if got := node.SubtreeNoteIDs(); !slices.Equal(got, tt.want) {
+				t.Errorf("Expected notes %v, got %v", tt.want, got)
+			}
+		})
+	}
+	for _, path := range []string{"proj", "project/api/auth/x", "missing"} {
+		if tree.Find(path) != nil {
+			t.Errorf("Expected no node for %q", path)
+		}
+	}
+}
+
+func TestTagTreeFormat(t *testing.T) {
+	want := `personal (1)
+project (3)
+├── api (2)
+│   └── auth (1)
+└── web (2)
+project-x (1)
+`
+	if got := testTagTree().Format(); got != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+}
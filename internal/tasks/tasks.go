@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+	"github.com/landanqrew/simple-jot/internal/timeutils"
+)
+
+// taskPattern matches Markdown task list items such as "- [ ] write docs", "* [x] ship" and
+// "1. [ ] review", capturing the checkbox state and the task text.
+var taskPattern = regexp.MustCompile(`^(\s*(?:[-*+]|\d+[.)])\s+\[)([ xX])(\]\s+)(.*)$`)
+
+// duePattern matches a due date written in a task, as "due:2025-07-01", "due:2025-07-01T14:00"
+// or "📅 2025-07-01".
+var duePattern = regexp.MustCompile(`(?:\bdue:|📅\s*)(\d{4}-\d{2}-\d{2}(?:T\d{2}:\d{2})?)`)
+
+// Task is a checkbox item found in a note's content.
+type Task struct {
+	NoteID    string
+	NoteTitle string
+	Line      int // 1-based line of the task in the note content
+	Text      string
+	Done      bool
+	Due       timeutils.Due // zero unless the task has a due date
+}
+
+// Ref returns the reference accepted by 'tasks done': the note ID and the task's line.
+func (t Task) Ref() string {
+	return fmt.Sprintf("%s:%d", t.NoteID, t.Line)
+}
+
+// Parse returns the task items in content, in order, with any due date taken out of the task text.
+// Items inside fenced code blocks are ignored.
+func Parse(content string) []Task {
+	found := make([]Task, 0)
+	inFence := false
+	for i, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		m := taskPattern.FindStringSubmatch(strings.TrimRight(line, "\r"))
+		if m == nil || strings.TrimSpace(m[4]) == "" {
+			continue
+		}
+		task := Task{Line: i + 1, Text: strings.TrimSpace(m[4]), Done: m[2] != " "}
+		if due := duePattern.FindStringSubmatch(task.Text); due != nil {
+			if d, err := timeutils.ParseDue(due[1], time.Now()); err == nil {
+				task.Due = d
+				task.Text = strings.Join(strings.Fields(strings.Replace(task.Text, due[0], "", 1)), " ")
+			}
+		}
+		found = append(found, task)
+	}
+	return found
+}
+
+// Extract returns the tasks of every note in noteList, in note order.
+func Extract(noteList []notes.Note) []Task {
+	found := make([]Task, 0)
+	for _, n := range noteList {
+		for _, task := range Parse(n.Content) {
+			task.NoteID = n.ID
+			task.NoteTitle = n.Title
+			found = append(found, task)
+		}
+	}
+	return found
+}
+
+// ParseRef splits a task reference "<note>:<line>" into the note reference and line number. The
+// note part may be anything that resolves to a note, such as an ID prefix or a title.
+func ParseRef(ref string) (string, int, error) {
+	i := strings.LastIndex(ref, ":")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid task reference '%s'. Use <note>:<line>, as shown by 'simple-jot tasks'", ref)
+	}
+	line, err := strconv.Atoi(ref[i+1:])
+	if err != nil || line < 1 {
+		return "", 0, fmt.Errorf("invalid line number in task reference '%s'", ref)
+	}
+	return ref[:i], line, nil
+}
+
+// SetDone ticks (or, with done false, clears) the checkbox of the task on the given 1-based line
+// of content and returns the updated content. The rest of the content is left untouched.
+func SetDone(content string, line int, done bool) (string, error) {
+	mark := " "
+	if done {
+		mark = "x"
+	}
+	return setCheckbox(content, line, mark, "")
+}
+
+// SetCarried marks the task on the given 1-based line of content as carried over to the note
+// titled to: "- [ ] ship" becomes "- [>] ship (carried to <to>)". Parse does not read such a line
+// as a task, so it is neither open nor done.
+func SetCarried(content string, line int, to string) (string, error) {
+	return setCheckbox(content, line, ">", " (carried to "+to+")")
+}
+
+// setCheckbox replaces the checkbox of the task on line with mark and appends suffix to the line.
+func setCheckbox(content string, line int, mark string, suffix string) (string, error) {
+	lines := strings.Split(content, "\n")
+	if line < 1 || line > len(lines) {
+		return content, fmt.Errorf("line %d is out of range", line)
+	}
+	isTask := false
+	for _, task := range Parse(content) {
+		if task.Line == line {
+			isTask = true
+			break
+		}
+	}
+	if !isTask {
+		return content, fmt.Errorf("line %d is not a task", line)
+	}
+
+	text, crlf := strings.CutSuffix(lines[line-1], "\r")
+	lines[line-1] = taskPattern.ReplaceAllString(text, "${1}"+mark+"${3}${4}") + suffix
+	if crlf {
+		lines[line-1] += "\r"
+	}
+	return strings.Join(lines, "\n"), nil
+}
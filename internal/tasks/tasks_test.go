@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+const testContent = `# Release
+- [ ] write changelog
+- [x] bump version
+  * [X] nested and done
+1. [ ] numbered
+- [] not a task
+- [ ]
+` + "```" + `
+- [ ] inside a code block
+` + "```" + `
++ [ ] last one`
+
+func TestParse(t *testing.T) {
+	want := []Task{
+		{Line: 2, Text: "write changelog"},
+		{Line: 3, Text: "bump version", Done: true},
+		{Line: 4, Text: "nested and done", Done: true},
+		{Line: 5, Text: "numbered"},
+		{Line: 11, Text: "last one"},
+	}
+	if got := Parse(testContent); !slices.Equal(got, want) {
+		t.Errorf("Parse() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseDueDates(t *testing.T) {
+	got := Parse("- [ ] ship release due:2025-07-01\n- [ ] 📅 2025-07-02 call vendor\n- [ ] review due:2025-07-03T14:30\n- [ ] due:someday")
+	want := []struct {
+		text string
+		due  string
+	}{
+		{text: "ship release", due: "2025-07-01"},
+		{text: "call vendor", due: "2025-07-02"},
+		{text: "review", due: "2025-07-03 14:30"},
+		{text: "due:someday", due: ""},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d tasks, got %+v", len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Text != w.text || got[i].Due.String() != w.due {
+			t.Errorf("Task %d: expected %q due %q, got %q due %q", i, w.text, w.due, got[i].Text, got[i].Due.String())
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	got := Extract([]notes.Note{
+		{ID: "a", Title: "A", Content: "- [ ] one"},
+		{ID: "b", Title: "B", Content: "no tasks"},
+		{ID: "c", Title: "C", Content: "intro\n- [x] two"},
+	})
+	want := []Task{
+		{NoteID: "a", NoteTitle: "A", Line: 1, Text: "one"},
+		{NoteID: "c", NoteTitle: "C", Line: 2, Text: "two", Done: true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Extract() = %+v, want %+v", got, want)
+	}
+	if got[1].Ref() != "c:2" {
+		t.Errorf("Expected ref c:2, got %s", got[1].Ref())
+	}
+}
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		ref      string
+		wantNote string
+		wantLine int
+		wantErr  bool
+	}{
+		{ref: "3f2a:12", wantNote: "3f2a", wantLine: 12},
+		{ref: "Release: v2:3", wantNote: "Release: v2", wantLine: 3},
+		{ref: "3f2a", wantErr: true},
+		{ref: ":3", wantErr: true},
+		{ref: "3f2a:0", wantErr: true},
+		{ref: "3f2a:x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			note, line, err := ParseRef(tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error but got none")
+				}
+				return
+			}
+			if err != nil || note != tt.wantNote || line != tt.wantLine {
+				t.Errorf("ParseRef(%q) = %q, %d, %v; want %q, %d", tt.ref, note, line, err, tt.wantNote, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestSetDone(t *testing.T) {
+	content := "intro\n  - [ ] write changelog\n- [x] bump version\n"
+
+	got, err := SetDone(content, 2, true)
+	if err != nil {
+		t.Fatalf("SetDone returned an error: %v", err)
+	}
+	if want := "intro\n  - [x] write changelog\n- [x] bump version\n"; got != want {
+		t.Errorf("SetDone(2, true) = %q, want %q", got, want)
+	}
+
+	got, err = SetDone(content, 3, false)
+	if err != nil {
+		t.Fatalf("SetDone returned an error: %v", err)
+	}
+	if want := "intro\n  - [ ] write changelog\n- [ ] bump version\n"; got != want {
+		t.Errorf("SetDone(3, false) = %q, want %q", got, want)
+	}
+
+	for _, line := range []int{0, 1, 5} {
+		if _, err := SetDone(content, line, true); err == nil {
+			t.Errorf("Expected an error for line %d", line)
+		}
+	}
+}
+
+func TestSetCarried(t *testing.T) {
+	content := "- [ ] write changelog due:2025-07-01\r\n- [x] bump version"
+
+	got, err := SetCarried(content, 1, "2025-07-02")
+	if err != nil {
+		t.Fatalf("SetCarried returned an error: %v", err)
+	}
+	if want := "- [>] write changelog due:2025-07-01 (carried to 2025-07-02)\r\n- [x] bump version"; got != want {
+		t.Errorf("SetCarried(1) = %q, want %q", got, want)
+	}
+	if found := Parse(got); len(found) != 1 || found[0].Line != 2 {
+		t.Errorf("Expected the carried task not to be parsed as a task, got %+v", found)
+	}
+	if _, err := SetCarried(got, 1, "2025-07-03"); err == nil {
+		t.Error("Expected an error carrying a line that is no longer a task")
+	}
+}
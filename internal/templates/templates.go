@@ -0,0 +1,139 @@
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+// Ext is the file extension of templates in the templates directory.
+const Ext = ".md"
+
+// Skeleton is written by 'template new' as a starting point for a new template.
+const Skeleton = `---
+tags: []
+---
+# {{.Title}}
+
+Date: {{.Date}}
+{{if .ActiveNote}}Related: [[{{.ActiveNote}}]]
+{{end}}
+## Notes
+
+- {{prompt "first point"}}
+`
+
+// Dir returns the templates directory inside dataDir.
+func Dir(dataDir string) string {
+	return filepath.Join(dataDir, "templates")
+}
+
+// ValidateName checks that name can be used as a template file name.
+func ValidateName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid template name '%s'", name)
+	}
+	return nil
+}
+
+// Path returns the file of the template called name in dir.
+func Path(dir string, name string) string {
+	return filepath.Join(dir, name+Ext)
+}
+
+// List returns the names of the templates in dir, sorted. A missing directory has no templates.
+func List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read templates directory: %w", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if name, ok := strings.CutSuffix(entry.Name(), Ext); ok && !entry.IsDir() && ValidateName(name) == nil {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// Load returns the text of the template called name in dir.
+func Load(dir string, name string) (string, error) {
+	if err := ValidateName(name); err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(Path(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("template '%s' not found in %s", name, dir)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot read template '%s': %w", name, err)
+	}
+	return string(data), nil
+}
+
+// Data holds the variables available to a template.
+type Data struct {
+	Title        string    // title given to 'create'
+	Date         string    // today as 2006-01-02
+	Time         string    // the time of day as 15:04
+	Now          time.Time // for other layouts, e.g. {{.Now.Format "Monday 2 Jan"}}
+	ActiveNote   string    // title of the active note, empty when none is set
+	ActiveNoteID string
+}
+
+// NewData returns the variables for a note titled title created at now.
+func NewData(title string, now time.Time, activeNote *notes.Note) Data {
+	data := Data{Title: title, Date: now.Format(time.DateOnly), Time: now.Format("15:04"), Now: now}
+	if activeNote != nil {
+		data.ActiveNote = activeNote.Title
+		data.ActiveNoteID = activeNote.ID
+	}
+	return data
+}
+
+// Render executes the template text with data and parses the result as a note: tags, due date and
+// properties in its front matter become the note's defaults, and a title replaces the one given.
+// Each {{prompt "name"}} in the template is answered once by calling prompt with the name.
+func Render(name string, text string, data Data, prompt func(name string) (string, error)) (notes.FrontMatter, error) {
+	answers := make(map[string]string)
+	funcs := template.FuncMap{
+		"prompt": func(name string) (string, error) {
+			if answer, ok := answers[name]; ok {
+				return answer, nil
+			}
+			answer, err := prompt(name)
+			if err != nil {
+				return "", err
+			}
+			answers[name] = answer
+			return answer, nil
+		},
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(funcs).Parse(text)
+	if err != nil {
+		return notes.FrontMatter{}, fmt.Errorf("cannot parse template '%s': %w", name, err)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return notes.FrontMatter{}, fmt.Errorf("cannot render template '%s': %w", name, err)
+	}
+
+	fm, err := notes.ParseFrontMatter(b.String())
+	if err != nil {
+		return notes.FrontMatter{}, fmt.Errorf("cannot parse front matter of template '%s': %w", name, err)
+	}
+	return fm, nil
+}
@@ -0,0 +1,85 @@
+package templates
+
+import (
+	"maps"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/landanqrew/simple-jot/internal/notes"
+)
+
+func TestRender(t *testing.T) {
+	text := "---\ntitle: ADR: {{.Title}}\ntags: [adr]\nstatus: proposed\n---\n# {{.Title}}\n" +
+		"{{.Date}} {{.Time}} {{.Now.Format \"Jan 2\"}}\nSee [[{{.ActiveNote}}]]\n" +
+		"Owner: {{prompt \"owner\"}}, again {{prompt \"owner\"}}\n"
+	now := time.Date(2025, 7, 1, 9, 30, 0, 0, time.Local)
+	data := NewData("Use Postgres", now, &notes.Note{ID: "note1", Title: "Architecture"})
+
+	asked := 0
+	fm, err := Render("adr", text, data, func(name string) (string, error) {
+		asked++
+		return "kim", nil
+	})
+	if err != nil {
+		t.Fatalf("Render returned an error: %v", err)
+	}
+	if asked != 1 {
+		t.Errorf("Expected the repeated prompt to be asked once, got %d", asked)
+	}
+	if fm.Title != "ADR: Use Postgres" || !slices.Equal(fm.Tags, []string{"adr"}) ||
+		!maps.Equal(fm.Properties, map[string]string{"status": "proposed"}) {
+		t.Errorf("Unexpected front matter %+v", fm)
+	}
+	expected := "# Use Postgres\n2025-07-01 09:30 Jul 1\nSee [[Architecture]]\nOwner: kim, again kim\n"
+	if fm.Content != expected {
+		t.Errorf("Expected content %q, got %q", expected, fm.Content)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	noPrompt := func(string) (string, error) { return "", nil }
+	for _, text := range []string{"{{.Title", "{{.Unknown}}", "{{nope}}"} {
+		if _, err := Render("bad", text, Data{}, noPrompt); err == nil {
+			t.Errorf("Expected an error rendering %q", text)
+		}
+	}
+	if _, err := Render("skeleton", Skeleton, Data{}, noPrompt); err != nil {
+		t.Errorf("Expected the skeleton to render, got %v", err)
+	}
+}
+
+func TestListAndLoad(t *testing.T) {
+	dir := Dir(t.TempDir())
+	names, err := List(dir)
+	if err != nil || len(names) != 0 {
+		t.Fatalf("Expected no templates in a missing directory, got %v, %v", names, err)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"meeting.md", "adr.md", "notes.txt", ".hidden.md"} {
+		if err := os.WriteFile(dir+"/"+file, []byte("# "+file), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names, err = List(dir)
+	if err != nil {
+		t.Fatalf("List returned an error: %v", err)
+	}
+	if !slices.Equal(names, []string{"adr", "meeting"}) {
+		t.Errorf("Expected [adr meeting], got %v", names)
+	}
+	if text, err := Load(dir, "adr"); err != nil || text != "# adr.md" {
+		t.Errorf("Expected the adr template, got %q, %v", text, err)
+	}
+	if _, err := Load(dir, "missing"); err == nil {
+		t.Error("Expected an error for a missing template")
+	}
+	if _, err := Load(dir, "../adr"); err == nil {
+		t.Error("Expected an error for a name with a path separator")
+	}
+}
@@ -0,0 +1,204 @@
+package timeutils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dueTimeLayout is how a due time with a time of day is stored and shown.
+const dueTimeLayout = "2006-01-02 15:04"
+
+// Due is a due date, optionally with a time of day. A due date without a time (AllDay) is due
+// for the whole day and only becomes overdue the day after.
+type Due struct {
+	Time   time.Time
+	AllDay bool
+}
+
+// IsZero reports whether no due date is set.
+func (d Due) IsZero() bool {
+	return d.Time.IsZero()
+}
+
+// String formats the due date as YYYY-MM-DD, or YYYY-MM-DD HH:MM when it has a time of day.
+// ParseDueValue reads it back.
+func (d Due) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	if d.AllDay {
+		return d.Time.Format(time.DateOnly)
+	}
+	return d.Time.Format(dueTimeLayout)
+}
+
+// Day returns the start of the day the due date falls on.
+func (d Due) Day() time.Time {
+	return StartOfDay(d.Time)
+}
+
+// Overdue reports whether the due date has passed at now: the day is over for an all-day due
+// date, the time has passed otherwise.
+func (d Due) Overdue(now time.Time) bool {
+	if d.AllDay {
+		return d.Day().Before(StartOfDay(now))
+	}
+	return d.Time.Before(now)
+}
+
+// ParseDueValue reads a due date stored with Due.String. An empty value is a zero Due.
+func ParseDueValue(value string) (Due, error) {
+	if value == "" {
+		return Due{}, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return Due{Time: t, AllDay: true}, nil
+	}
+	t, err := time.ParseInLocation(dueTimeLayout, value, time.Local)
+	if err != nil {
+		return Due{}, fmt.Errorf("invalid due date '%s'", value)
+	}
+	return Due{Time: t}, nil
+}
+
+// isoLayouts are the absolute formats accepted by ParseDue, with whether they carry a time.
+var isoLayouts = []struct {
+	layout  string
+	hasTime bool
+}{
+	{time.DateOnly, false},
+	{dueTimeLayout, true},
+	{"2006-01-02T15:04", true},
+	{time.DateTime, true},
+	{"2006-01-02T15:04:05", true},
+}
+
+var (
+	// relativePattern matches "in 3 days", "in 2 weeks", "3d" or "2w".
+	relativePattern = regexp.MustCompile(`^(?:in\s+)?(\d+)\s*(m|min|mins|minutes?|h|hours?|d|days?|w|weeks?|months?)$`)
+	// clockPattern matches a time of day such as "9am", "5:30pm" or "17:00".
+	clockPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+)
+
+// ParseDue parses when as a due date relative to now. It accepts ISO dates ("2025-07-01",
+// "2025-07-01 14:00", RFC 3339) and natural language: "today", "tomorrow", "tonight", weekday
+// names ("friday", "next friday"), "next week", "next month" and periods ("in 3 days", "2w",
+// "in 4 hours"). Day-based values can end in a time of day, as in "tomorrow 9am" or
+// "friday at 17:00".
+func ParseDue(when string, now time.Time) (Due, error) {
+	when = strings.Join(strings.Fields(when), " ")
+	if when == "" {
+		return Due{}, fmt.Errorf("due date cannot be empty")
+	}
+	invalid := fmt.Errorf("invalid due date '%s'. Use a date like 2025-07-01 or 2025-07-01 14:00, or e.g. 'tomorrow 9am', 'friday' or 'in 3 days'", when)
+
+	for _, l := range isoLayouts {
+		if t, err := time.ParseInLocation(l.layout, when, now.Location()); err == nil {
+			return Due{Time: t, AllDay: !l.hasTime}, nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, when); err == nil {
+		return Due{Time: t.In(now.Location())}, nil
+	}
+	when = strings.ToLower(when)
+
+	if m := relativePattern.FindStringSubmatch(when); m != nil {
+		amount, _ := strconv.Atoi(m[1])
+		switch unit := m[2]; {
+		case unit == "m" || strings.HasPrefix(unit, "min"):
+			return Due{Time: now.Add(time.Duration(amount) * time.Minute).Truncate(time.Minute)}, nil
+		case strings.HasPrefix(unit, "h"):
+			return Due{Time: now.Add(time.Duration(amount) * time.Hour).Truncate(time.Minute)}, nil
+		case strings.HasPrefix(unit, "d"):
+			return Due{Time: StartOfDay(now).AddDate(0, 0, amount), AllDay: true}, nil
+		case strings.HasPrefix(unit, "w"):
+			return Due{Time: StartOfDay(now).AddDate(0, 0, 7*amount), AllDay: true}, nil
+		default:
+			return Due{Time: StartOfDay(now).AddDate(0, amount, 0), AllDay: true}, nil
+		}
+	}
+
+	// split off a trailing time of day: "tomorrow 9am", "friday at 17:00"
+	dayPart, clock := when, ""
+	if i := strings.LastIndex(when, " "); i > 0 {
+		if _, _, ok := parseClock(when[i+1:]); ok {
+			dayPart, clock = strings.TrimSuffix(strings.TrimSpace(when[:i]), " at"), when[i+1:]
+		}
+	}
+
+	day, ok := parseDay(dayPart, now)
+	if !ok {
+		return Due{}, invalid
+	}
+	if dayPart == "tonight" && clock == "" {
+		clock = "20:00"
+	}
+	if clock == "" {
+		return Due{Time: day, AllDay: true}, nil
+	}
+	hour, minute, _ := parseClock(clock)
+	return Due{Time: time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, now.Location())}, nil
+}
+
+// parseDay resolves a day name relative to now to the start of that day.
+func parseDay(value string, now time.Time) (time.Time, bool) {
+	today := StartOfDay(now)
+	switch value {
+	case "today", "tonight":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	case "next week":
+		return today.AddDate(0, 0, 7), true
+	case "next month":
+		return today.AddDate(0, 1, 0), true
+	}
+
+	name := strings.TrimPrefix(value, "next ")
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		full := strings.ToLower(weekday.String())
+		if name == full || name == full[:3] {
+			// the next such day after today
+			days := (int(weekday)-int(today.Weekday())+6)%7 + 1
+			return today.AddDate(0, 0, days), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseClock parses a time of day such as "9am", "5:30pm" or "17:00".
+func parseClock(value string) (hour int, minute int, ok bool) {
+	m := clockPattern.FindStringSubmatch(value)
+	if m == nil || (m[2] == "" && m[3] == "") {
+		// a bare number is not a time of day
+		return 0, 0, false
+	}
+	hour, _ = strconv.Atoi(m[1])
+	if m[2] != "" {
+		minute, _ = strconv.Atoi(m[2])
+	}
+	switch m[3] {
+	case "am":
+		if hour < 1 || hour > 12 {
+			return 0, 0, false
+		}
+		hour %= 12
+	case "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, false
+		}
+		hour = hour%12 + 12
+	}
+	if hour > 23 || minute > 59 {
+		return 0, 0, false
+	}
+	return hour, minute, true
+}
+
+// StartOfDay returns midnight at the start of t's day.
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,89 @@
+package timeutils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDue(t *testing.T) {
+	now := time.Date(2025, 7, 15, 12, 0, 0, 0, time.Local) // a Tuesday
+	day := func(d int) time.Time { return time.Date(2025, 7, d, 0, 0, 0, 0, time.Local) }
+	at := func(d, h, m int) time.Time { return time.Date(2025, 7, d, h, m, 0, 0, time.Local) }
+
+	tests := []struct {
+		input       string
+		expected    Due
+		expectError bool
+	}{
+		{input: "2025-07-20", expected: Due{Time: day(20), AllDay: true}},
+		{input: "2025-07-20 14:30", expected: Due{Time: at(20, 14, 30)}},
+		{input: "2025-07-20T14:30", expected: Due{Time: at(20, 14, 30)}},
+		{input: "today", expected: Due{Time: day(15), AllDay: true}},
+		{input: "Tomorrow", expected: Due{Time: day(16), AllDay: true}},
+		{input: "tomorrow 9am", expected: Due{Time: at(16, 9, 0)}},
+		{input: "tomorrow at 5:30pm", expected: Due{Time: at(16, 17, 30)}},
+		{input: "tonight", expected: Due{Time: at(15, 20, 0)}},
+		{input: "friday", expected: Due{Time: day(18), AllDay: true}},
+		{input: "next fri 17:00", expected: Due{Time: at(18, 17, 0)}},
+		{input: "tuesday", expected: Due{Time: day(22), AllDay: true}},
+		{input: "next week", expected: Due{Time: day(22), AllDay: true}},
+		{input: "next month", expected: Due{Time: time.Date(2025, 8, 15, 0, 0, 0, 0, time.Local), AllDay: true}},
+		{input: "in 3 days", expected: Due{Time: day(18), AllDay: true}},
+		{input: "2w", expected: Due{Time: day(29), AllDay: true}},
+		{input: "in 4 hours", expected: Due{Time: at(15, 16, 0)}},
+		{input: "90m", expected: Due{Time: at(15, 13, 30)}},
+		{input: "", expectError: true},
+		{input: "someday", expectError: true},
+		{input: "tomorrow 25:00", expectError: true},
+		{input: "friday 13pm", expectError: true},
+		{input: "2025-13-01", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDue(tt.input, now)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected an error but got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if !got.Time.Equal(tt.expected.Time) || got.AllDay != tt.expected.AllDay {
+				t.Errorf("Expected %v (all day: %t), got %v (all day: %t)", tt.expected.Time, tt.expected.AllDay, got.Time, got.AllDay)
+			}
+		})
+	}
+}
+
+func TestDueRoundTripAndOverdue(t *testing.T) {
+	now := time.Date(2025, 7, 15, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		value   string
+		overdue bool
+	}{
+		{value: "2025-07-14", overdue: true},
+		{value: "2025-07-15", overdue: false},
+		{value: "2025-07-15 11:59", overdue: true},
+		{value: "2025-07-15 12:30", overdue: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			due, err := ParseDueValue(tt.value)
+			if err != nil {
+				t.Fatalf("ParseDueValue returned an error: %v", err)
+			}
+			if due.String() != tt.value {
+				t.Errorf("Expected %q after round trip, got %q", tt.value, due.String())
+			}
+			if due.Overdue(now) != tt.overdue {
+				t.Errorf("Expected Overdue to be %t", tt.overdue)
+			}
+		})
+	}
+	if due, err := ParseDueValue(""); err != nil || !due.IsZero() {
+		t.Errorf("Expected an empty value to be a zero Due, got %v, %v", due, err)
+	}
+}
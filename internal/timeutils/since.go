@@ -0,0 +1,45 @@
+package timeutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseSince converts a relative period such as "7d", "2w", "12h" or "90m", or an absolute date
+// in YYYY-MM-DD format, into the point in time it refers to relative to now.
+func ParseSince(since string, now time.Time) (time.Time, error) {
+	since = strings.TrimSpace(since)
+	if since == "" {
+		return time.Time{}, fmt.Errorf("since cannot be empty")
+	}
+
+	if t, err := time.ParseInLocation(time.DateOnly, since, now.Location()); err == nil {
+		return t, nil
+	}
+
+	unit := since[len(since)-1]
+	amount, err := strconv.Atoi(since[:len(since)-1])
+	if err == nil && amount >= 0 {
+		switch unit {
+		case 'd':
+			return now.AddDate(0, 0, -amount), nil
+		case 'w':
+			return now.AddDate(0, 0, -7*amount), nil
+		case 'y':
+			return now.AddDate(-amount, 0, 0), nil
+		}
+	}
+
+	d, err := time.ParseDuration(since)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid since value '%s'. Use a period like 7d, 2w or 12h, or a date in YYYY-MM-DD format", since)
+	}
+	return now.Add(-d), nil
+}
+
+// ParseNoteTime parses a note timestamp (time.DateTime format) in the local time zone.
+func ParseNoteTime(value string) (time.Time, error) {
+	return time.ParseInLocation(time.DateTime, value, time.Local)
+}
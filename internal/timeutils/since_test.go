@@ -0,0 +1,43 @@
+package timeutils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 7, 15, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		input       string
+		expected    time.Time
+		expectError bool
+	}{
+		{input: "7d", expected: time.Date(2025, 7, 8, 12, 0, 0, 0, time.Local)},
+		{input: "2w", expected: time.Date(2025, 7, 1, 12, 0, 0, 0, time.Local)},
+		{input: "1y", expected: time.Date(2024, 7, 15, 12, 0, 0, 0, time.Local)},
+		{input: "12h", expected: time.Date(2025, 7, 15, 0, 0, 0, 0, time.Local)},
+		{input: "90m", expected: time.Date(2025, 7, 15, 10, 30, 0, 0, time.Local)},
+		{input: "2025-07-01", expected: time.Date(2025, 7, 1, 0, 0, 0, 0, time.Local)},
+		{input: "", expectError: true},
+		{input: "last week", expectError: true},
+		{input: "-3d", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSince(tt.input, now)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected an error but got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Did not expect an error but got: %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}